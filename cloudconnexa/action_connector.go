@@ -0,0 +1,186 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// connectorTypes are the valid `connector_type` values of connector actions.
+var connectorTypes = []string{"HOST", "NETWORK"}
+
+// providerActions returns constructors for the provider's actions by type
+// name. Actions run one-shot operations from `action_trigger` blocks or
+// `terraform apply -invoke` and keep no state.
+func providerActions() map[string]func() action.Action {
+	return map[string]func() action.Action{
+		"cloudconnexa_connector_activate": func() action.Action {
+			return &connectorStatusAction{connectorAction: connectorAction{typeName: "cloudconnexa_connector_activate"}, status: "ACTIVE"}
+		},
+		"cloudconnexa_connector_restart_ipsec": func() action.Action {
+			return &connectorRestartIPsecAction{connectorAction: connectorAction{typeName: "cloudconnexa_connector_restart_ipsec"}}
+		},
+		"cloudconnexa_connector_suspend": func() action.Action {
+			return &connectorStatusAction{connectorAction: connectorAction{typeName: "cloudconnexa_connector_suspend"}, status: "SUSPENDED"}
+		},
+	}
+}
+
+// connectorAction implements the parts common to the connector actions:
+// the type name and the client passed by frameworkProvider.
+type connectorAction struct {
+	typeName string
+	client   *cloudconnexa.Client
+}
+
+// Metadata implements action.Action.
+func (a *connectorAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.typeName
+}
+
+// Configure implements action.ActionWithConfigure.
+func (a *connectorAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if c := providerDataClient(req.ProviderData, &resp.Diagnostics); c != nil {
+		a.client = c
+	}
+}
+
+// clientFor returns the client bound to ctx, or nil after adding an error
+// to resp if the provider is not configured or is in read-only mode.
+func (a *connectorAction) clientFor(ctx context.Context, resp *action.InvokeResponse) *cloudconnexa.Client {
+	if a.client == nil {
+		resp.Diagnostics.AddError("Unable to invoke "+a.typeName, errProviderNotConfigured.Error())
+		return nil
+	}
+	if isReadOnly(a.client) {
+		for _, d := range readOnlyDiagnostics(a.typeName, "invoke") {
+			resp.Diagnostics.AddError(d.Summary, d.Detail)
+		}
+		return nil
+	}
+	return clientFromMeta(ctx, a.client)
+}
+
+// connectorIDAttribute is the `id` attribute of connector actions.
+var connectorIDAttribute = schema.StringAttribute{
+	Required:    true,
+	Description: "The ID of the connector.",
+}
+
+// connectorTypeAttribute is the `connector_type` attribute of actions on
+// host and network connectors.
+var connectorTypeAttribute = schema.StringAttribute{
+	Required:    true,
+	Description: "The type of the connector. Valid values are `HOST` and `NETWORK`.",
+	Validators:  []validator.String{stringvalidator.OneOf(connectorTypes...)},
+}
+
+// connectorRestartIPsecAction is the `cloudconnexa_connector_restart_ipsec`
+// action, which restarts the IPsec tunnel of a network connector. The API
+// does not restart a running tunnel when asked to start it, so the tunnel
+// is stopped first.
+type connectorRestartIPsecAction struct {
+	connectorAction
+}
+
+var _ action.ActionWithConfigure = (*connectorRestartIPsecAction)(nil)
+
+// connectorRestartIPsecModel is the config of connectorRestartIPsecAction.
+type connectorRestartIPsecModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// Schema implements action.Action.
+func (a *connectorRestartIPsecAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts the IPsec tunnel of a network connector with an `ipsec_config` by stopping and starting it.",
+		Attributes: map[string]schema.Attribute{
+			"id": connectorIDAttribute,
+		},
+	}
+}
+
+// Invoke implements action.Action.
+func (a *connectorRestartIPsecAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config connectorRestartIPsecModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	c := a.clientFor(ctx, resp)
+	if c == nil {
+		return
+	}
+	id := config.ID.ValueString()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Stopping IPsec on network connector %s", id)})
+	if err := c.NetworkConnectors.StopIPsec(id); err != nil {
+		resp.Diagnostics.AddError("Unable to invoke "+a.typeName, err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Starting IPsec on network connector %s", id)})
+	if err := c.NetworkConnectors.StartIPsec(id); err != nil {
+		resp.Diagnostics.AddError("Unable to invoke "+a.typeName, err.Error())
+	}
+}
+
+// connectorStatusAction is the action that sets the status of a host or
+// network connector to status, either `ACTIVE` or `SUSPENDED`.
+type connectorStatusAction struct {
+	connectorAction
+	status string
+}
+
+var _ action.ActionWithConfigure = (*connectorStatusAction)(nil)
+
+// connectorStatusModel is the config of connectorStatusAction.
+type connectorStatusModel struct {
+	ConnectorType types.String `tfsdk:"connector_type"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Schema implements action.Action.
+func (a *connectorStatusAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	verb := map[string]string{"ACTIVE": "Activates", "SUSPENDED": "Suspends"}[a.status]
+	resp.Schema = schema.Schema{
+		Description: verb + " a host or network connector, e.g. during an incident. The `status` argument of the connector resource is not changed.",
+		Attributes: map[string]schema.Attribute{
+			"connector_type": connectorTypeAttribute,
+			"id":             connectorIDAttribute,
+		},
+	}
+}
+
+// Invoke implements action.Action.
+func (a *connectorStatusAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config connectorStatusModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	c := a.clientFor(ctx, resp)
+	if c == nil {
+		return
+	}
+	connectorType, id := config.ConnectorType.ValueString(), config.ID.ValueString()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Setting status of %s connector %s to %s", connectorType, id, a.status)})
+	var err error
+	switch {
+	case connectorType == "HOST" && a.status == "ACTIVE":
+		err = c.HostConnectors.Activate(id)
+	case connectorType == "HOST":
+		err = c.HostConnectors.Suspend(id)
+	case a.status == "ACTIVE":
+		err = c.NetworkConnectors.Activate(id)
+	default:
+		err = c.NetworkConnectors.Suspend(id)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to invoke "+a.typeName, err.Error())
+	}
+}
@@ -0,0 +1,118 @@
+package cloudconnexa
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// invokeTestAction validates and invokes actionType through server with the
+// given string attributes and returns the progress messages and final
+// diagnostics.
+func invokeTestAction(t *testing.T, server frameworkTestServer, actionType string, attrs map[string]string) ([]string, []*tfprotov5.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	typ := schemas.ActionSchemas[actionType].Schema.ValueType()
+	vals := map[string]tftypes.Value{}
+	for name := range typ.(tftypes.Object).AttributeTypes {
+		vals[name] = tftypes.NewValue(tftypes.String, nil)
+	}
+	for name, v := range attrs {
+		vals[name] = stringValue(v)
+	}
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, vals))
+	require.NoError(t, err)
+
+	validate, err := server.ValidateActionConfig(ctx, &tfprotov5.ValidateActionConfigRequest{ActionType: actionType, Config: &config})
+	require.NoError(t, err)
+	if len(validate.Diagnostics) > 0 {
+		return nil, validate.Diagnostics
+	}
+	stream, err := server.InvokeAction(ctx, &tfprotov5.InvokeActionRequest{ActionType: actionType, Config: &config})
+	require.NoError(t, err)
+	var progress []string
+	var diags []*tfprotov5.Diagnostic
+	completed := false
+	for event := range stream.Events {
+		switch e := event.Type.(type) {
+		case tfprotov5.ProgressInvokeActionEventType:
+			progress = append(progress, e.Message)
+		case tfprotov5.CompletedInvokeActionEventType:
+			diags, completed = e.Diagnostics, true
+		}
+	}
+	require.True(t, completed, actionType)
+	return progress, diags
+}
+
+// TestUnitConnectorActions checks that each action calls its API endpoint
+// and reports progress.
+func TestUnitConnectorActions(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	c.UpdateRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	p := Provider()
+	p.SetMeta(c)
+	server := newFrameworkTestServer(t, p)
+
+	progress, diags := invokeTestAction(t, server, "cloudconnexa_connector_restart_ipsec", map[string]string{"id": "c1"})
+	require.Empty(t, diags)
+	assert.Equal(t, []string{"Stopping IPsec on network connector c1", "Starting IPsec on network connector c1"}, progress)
+	_, diags = invokeTestAction(t, server, "cloudconnexa_connector_suspend", map[string]string{"id": "c2", "connector_type": "HOST"})
+	require.Empty(t, diags)
+	_, diags = invokeTestAction(t, server, "cloudconnexa_connector_activate", map[string]string{"id": "c3", "connector_type": "NETWORK"})
+	require.Empty(t, diags)
+
+	assert.Equal(t, []string{
+		"POST /api/v1/networks/connectors/c1/ipsec/stop",
+		"POST /api/v1/networks/connectors/c1/ipsec/start",
+		"PUT /api/v1/hosts/connectors/c2/suspend",
+		"PUT /api/v1/networks/connectors/c3/activate",
+	}, requests)
+}
+
+// TestUnitConnectorActions_Errors checks config validation, API errors,
+// read-only mode and an unconfigured provider.
+func TestUnitConnectorActions_Errors(t *testing.T) {
+	c := newHostUnitTestClient(t, hostsHandlerError(http.StatusNotFound))
+	c.UpdateRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	p := Provider()
+	p.SetMeta(c)
+	server := newFrameworkTestServer(t, p)
+
+	_, diags := invokeTestAction(t, server, "cloudconnexa_connector_suspend", map[string]string{"id": "c1", "connector_type": "DEVICE"})
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail, `value must be one of: ["HOST" "NETWORK"]`)
+	_, diags = invokeTestAction(t, server, "cloudconnexa_connector_activate", map[string]string{"id": "c1", "connector_type": "DEVICE"})
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail, `value must be one of: ["HOST" "NETWORK"]`)
+
+	_, diags = invokeTestAction(t, server, "cloudconnexa_connector_restart_ipsec", map[string]string{"id": "c1"})
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Unable to invoke cloudconnexa_connector_restart_ipsec", diags[0].Summary)
+
+	p.SetMeta(enableReadOnly(c))
+	server = newFrameworkTestServer(t, p)
+	_, diags = invokeTestAction(t, server, "cloudconnexa_connector_restart_ipsec", map[string]string{"id": "c1"})
+	require.Len(t, diags, 1)
+	assert.Equal(t, "CloudConnexa provider is in read-only mode", diags[0].Summary)
+
+	_, diags = invokeTestAction(t, newFrameworkTestServer(t, Provider()), "cloudconnexa_connector_restart_ipsec", map[string]string{"id": "c1"})
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail, "not been configured")
+}
@@ -0,0 +1,204 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// AccessTokenEnvVar is the environment variable name for a pre-issued CloudConnexa API access token
+const AccessTokenEnvVar = "CLOUDCONNEXA_ACCESS_TOKEN"
+
+// tokenPath is the OAuth token endpoint relative to the API base URL.
+const tokenPath = "/api/v1/oauth/token"
+
+// tokenExpiryDelta is how long before its expiry a token is refreshed.
+const tokenExpiryDelta = time.Minute
+
+// tokenSource issues CloudConnexa API access tokens.
+type tokenSource interface {
+	// Token returns a new access token.
+	Token(ctx context.Context) (Token, error)
+}
+
+// staticTokenSource always returns the same pre-issued token.
+type staticTokenSource struct {
+	token string
+}
+
+// Token implements tokenSource.
+func (s staticTokenSource) Token(_ context.Context) (Token, error) {
+	return Token{AccessToken: s.token}, nil
+}
+
+// clientCredentialsSource obtains tokens with the OAuth client-credentials
+// grant, the same handshake as cloudconnexa.NewClient.
+type clientCredentialsSource struct {
+	hc           *http.Client
+	baseURL      string
+	clientID     string
+	clientSecret string
+}
+
+// Token implements tokenSource.
+func (s clientCredentialsSource) Token(ctx context.Context) (Token, error) {
+	body, err := json.Marshal(map[string]string{"grant_type": "client_credentials", "scope": "default"})
+	if err != nil {
+		return Token{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+tokenPath, bytes.NewReader(body))
+	if err != nil {
+		return Token{}, err
+	}
+	req.SetBasicAuth(s.clientID, s.clientSecret)
+	req.Header.Set("Content-Type", "application/json")
+	return doTokenRequest(s.hc, req)
+}
+
+// doTokenRequest sends an OAuth token request and decodes the response.
+//
+// Parameters:
+//   - hc: The HTTP client to use
+//   - req: The token request
+//
+// Returns:
+//   - Token: The issued token, with Expiry set when the server sent `expires_in`
+//   - error: An error if the request failed or the response has no token
+func doTokenRequest(hc *http.Client, req *http.Request) (Token, error) {
+	req.Header.Set("Accept", "application/json")
+	resp, err := hc.Do(req)
+	if err != nil {
+		return Token{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, cloudconnexa.DefaultMaxTokenResponseSize+1))
+	if err != nil {
+		return Token{}, err
+	}
+	if int64(len(data)) > cloudconnexa.DefaultMaxTokenResponseSize {
+		return Token{}, fmt.Errorf("%w: OAuth response exceeded %d bytes", cloudconnexa.ErrResponseTooLarge, cloudconnexa.DefaultMaxTokenResponseSize)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Token{}, fmt.Errorf("OAuth token request failed with status code %d", resp.StatusCode)
+	}
+
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return Token{}, fmt.Errorf("decoding OAuth token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return Token{}, fmt.Errorf("OAuth token response did not contain an access_token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// normalizeBaseURL validates an API base URL and reduces it to
+// `https://host[:port]`, matching what the go-client accepts.
+func normalizeBaseURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", cloudconnexa.ErrInvalidBaseURL, err)
+	}
+	if !strings.EqualFold(u.Scheme, "https") {
+		return "", fmt.Errorf("%w: %q", cloudconnexa.ErrHTTPSRequired, raw)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("%w: URL must include a host", cloudconnexa.ErrInvalidBaseURL)
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("%w: URL must not contain credentials", cloudconnexa.ErrInvalidBaseURL)
+	}
+	return "https://" + u.Host, nil
+}
+
+// authConfig selects how the provider authenticates. AccessToken wins over
+// the client credentials.
+type authConfig struct {
+	AccessToken  string
+	ClientID     string
+	ClientSecret string
+}
+
+// configured reports whether any authentication mode is set.
+func (a authConfig) configured() bool {
+	return a.AccessToken != "" || a.ClientID != "" || a.ClientSecret != ""
+}
+
+// tokenSource returns the token source for the selected mode. Token
+// requests are sent through hc.
+func (a authConfig) tokenSource(hc *http.Client, baseURL string) (tokenSource, error) {
+	switch {
+	case a.AccessToken != "":
+		return staticTokenSource{token: a.AccessToken}, nil
+	case a.ClientID != "" && a.ClientSecret != "":
+		return clientCredentialsSource{hc: hc, baseURL: baseURL, clientID: a.ClientID, clientSecret: a.ClientSecret}, nil
+	default:
+		return nil, cloudconnexa.ErrCredentialsRequired
+	}
+}
+
+// newTokenClient builds a *cloudconnexa.Client that sends every request
+// through hc with token, without the go-client's built-in handshake.
+//
+// Parameters:
+//   - baseURL: The normalized API base URL
+//   - token: The initial API access token
+//   - hc: The HTTP client to use for API requests
+//
+// Returns:
+//   - *cloudconnexa.Client: The configured client
+//   - error: An error if baseURL is not a valid API URL
+func newTokenClient(baseURL, token string, hc *http.Client) (*cloudconnexa.Client, error) {
+	c, err := cloudconnexa.NewClientWithToken(baseURL, token, &cloudconnexa.ClientOptions{HTTPClient: hc})
+	if err != nil {
+		return nil, err
+	}
+	c.UserAgent = fmt.Sprintf("terraform-provider-cloudconnexa/%v", version)
+	return c, nil
+}
+
+// newAPIClient authenticates with the mode selected in auth and returns a
+// client whose requests carry a token from that mode, refreshed before it
+// expires. The first token is requested immediately so that bad settings
+// are reported when the provider is configured.
+//
+// Parameters:
+//   - ctx: The context for the initial token request
+//   - hc: The HTTP client to use for token and API requests
+//   - baseURL: The API base URL
+//   - auth: The authentication settings
+//
+// Returns:
+//   - *cloudconnexa.Client: The authenticated client
+//   - error: An error if the settings are invalid or authentication failed
+func newAPIClient(ctx context.Context, hc *http.Client, baseURL string, auth authConfig) (*cloudconnexa.Client, error) {
+	if !auth.configured() {
+		return nil, cloudconnexa.ErrCredentialsRequired
+	}
+	normalized, err := normalizeBaseURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	source, err := auth.tokenSource(hc, normalized)
+	if err != nil {
+		return nil, err
+	}
+	at := newAuthTransport(hc.Transport, source)
+	token, err := at.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newTokenClient(normalized, token.AccessToken, &http.Client{Transport: at, Timeout: hc.Timeout})
+}
@@ -0,0 +1,214 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnitProviderConfigure_AccessToken checks that a pre-issued token
+// skips the OAuth handshake and is sent with API requests.
+func TestUnitProviderConfigure_AccessToken(t *testing.T) {
+	var tokenCalls atomic.Int32
+	var gotAuth string
+	server, certPEM := newTLSAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == tokenPath {
+			tokenCalls.Add(1)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"id":"host-id"}`))
+	}))
+
+	c, diags := configureTestProvider(t, server.URL, map[string]interface{}{
+		"ca_cert_pem":  certPEM,
+		"access_token": "pre-issued",
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	_, err := c.Hosts.Get("host-id")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer pre-issued", gotAuth)
+	assert.Zero(t, tokenCalls.Load())
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// countingTokenSource issues numbered tokens with a fixed lifetime.
+type countingTokenSource struct {
+	calls    atomic.Int32
+	lifetime time.Duration
+	now      func() time.Time
+}
+
+// Token implements tokenSource.
+func (s *countingTokenSource) Token(_ context.Context) (Token, error) {
+	n := s.calls.Add(1)
+	tok := Token{AccessToken: fmt.Sprintf("token-%d", n)}
+	if s.lifetime > 0 {
+		tok.Expiry = s.now().Add(s.lifetime)
+	}
+	return tok, nil
+}
+
+// TestUnitAuthTransport_RefreshBeforeExpiry checks that the cached token is
+// reused until it is about to expire and then replaced.
+func TestUnitAuthTransport_RefreshBeforeExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	source := &countingTokenSource{lifetime: 10 * time.Minute, now: clock}
+
+	var seen []string
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		seen = append(seen, req.Header.Get("Authorization"))
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	})
+	at := newAuthTransport(next, source)
+	at.now = clock
+
+	do := func() {
+		req, _ := http.NewRequest(http.MethodGet, "https://acme.api.openvpn.com/api/v1/hosts", nil)
+		resp, err := at.RoundTrip(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	do()
+	now = now.Add(5 * time.Minute)
+	do()
+	now = now.Add(4*time.Minute + 30*time.Second)
+	do()
+
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-1", "Bearer token-2"}, seen)
+	assert.EqualValues(t, 2, source.calls.Load())
+}
+
+// TestUnitTokenValid covers expiry handling of Token.
+func TestUnitTokenValid(t *testing.T) {
+	now := time.Now()
+	assert.False(t, Token{}.valid(now))
+	assert.True(t, Token{AccessToken: "x"}.valid(now))
+	assert.True(t, Token{AccessToken: "x", Expiry: now.Add(time.Hour)}.valid(now))
+	assert.False(t, Token{AccessToken: "x", Expiry: now.Add(30 * time.Second)}.valid(now))
+}
+
+// newExpiringTokenServer returns a server that rejects `token-1` with 401
+// and echoes the request body for any other bearer token.
+func newExpiringTokenServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"token expired"}`))
+			return
+		}
+		_, _ = io.Copy(w, r.Body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestUnitAuthTransport_RefreshOn401 checks that a request rejected with
+// 401 is replayed, body included, with a freshly issued token.
+func TestUnitAuthTransport_RefreshOn401(t *testing.T) {
+	server := newExpiringTokenServer(t)
+	source := &countingTokenSource{}
+	hc := &http.Client{Transport: newAuthTransport(http.DefaultTransport, source)}
+
+	resp, err := hc.Post(server.URL+"/api/v1/networks", "application/json", strings.NewReader(`{"name":"n"}`))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"name":"n"}`, string(body))
+	assert.EqualValues(t, 2, source.calls.Load())
+}
+
+// TestUnitAuthTransport_ConcurrentRefresh checks that many requests failing
+// with the same expired token share a single refresh.
+func TestUnitAuthTransport_ConcurrentRefresh(t *testing.T) {
+	server := newExpiringTokenServer(t)
+	source := &countingTokenSource{}
+	at := newAuthTransport(http.DefaultTransport, source)
+	_, err := at.current(context.Background())
+	require.NoError(t, err)
+	hc := &http.Client{Transport: at}
+
+	var wg sync.WaitGroup
+	statuses := make([]int, 20)
+	for i := range statuses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := hc.Get(server.URL + "/api/v1/hosts")
+			if err != nil {
+				return
+			}
+			statuses[i] = resp.StatusCode
+			_ = resp.Body.Close()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, s := range statuses {
+		assert.Equal(t, http.StatusOK, s)
+	}
+	assert.EqualValues(t, 2, source.calls.Load())
+}
+
+// TestUnitAuthTransport_StaticToken401 checks that a 401 for a pre-issued
+// token that cannot be refreshed is returned as is.
+func TestUnitAuthTransport_StaticToken401(t *testing.T) {
+	server := newExpiringTokenServer(t)
+	hc := &http.Client{Transport: newAuthTransport(http.DefaultTransport, staticTokenSource{token: "token-1"})}
+
+	resp, err := hc.Get(server.URL + "/api/v1/hosts")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+// TestUnitAuthTransport_GoClient checks that go-client calls succeed when
+// the token expires between two requests.
+func TestUnitAuthTransport_GoClient(t *testing.T) {
+	var expired atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expired.Load() && r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"host-id"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	source := &countingTokenSource{}
+	at := newAuthTransport(http.DefaultTransport, source)
+	c, err := cloudconnexa.NewClientWithToken(server.URL, "", &cloudconnexa.ClientOptions{
+		AllowInsecureHTTP: true,
+		HTTPClient:        &http.Client{Transport: at},
+	})
+	require.NoError(t, err)
+
+	_, err = c.Hosts.Get("host-id")
+	require.NoError(t, err)
+	expired.Store(true)
+	_, err = clientFromMeta(context.Background(), c).Hosts.Get("host-id")
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, source.calls.Load())
+}
@@ -0,0 +1,117 @@
+package cloudconnexa
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// authTransport is an http.RoundTripper that sets the Authorization header
+// of every API request from a token source. The token is shared by all
+// goroutines and refreshed under a mutex shortly before it expires, so
+// requests issued by long applies never carry a stale token. When the API
+// still answers 401 Unauthorized, for example because the token was revoked
+// or expired earlier than announced, the token is refreshed once and the
+// request replayed.
+type authTransport struct {
+	next   http.RoundTripper
+	source tokenSource
+	now    func() time.Time
+
+	mu    sync.Mutex
+	token Token
+}
+
+// newAuthTransport wraps next with token authentication from source.
+func newAuthTransport(next http.RoundTripper, source tokenSource) *authTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &authTransport{next: next, source: source, now: time.Now}
+}
+
+// current returns the cached token, requesting a new one when none is
+// cached yet or the cached one is about to expire.
+func (t *authTransport) current(ctx context.Context) (Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token.valid(t.now()) {
+		return t.token, nil
+	}
+	token, err := t.source.Token(ctx)
+	if err != nil {
+		return Token{}, err
+	}
+	t.token = token
+	return token, nil
+}
+
+// refresh replaces stale with a new token. When another goroutine has
+// already replaced it, the newer token is returned without another request,
+// so a burst of 401s triggers a single refresh.
+func (t *authTransport) refresh(ctx context.Context, stale Token) (Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token.AccessToken != stale.AccessToken && t.token.valid(t.now()) {
+		return t.token, nil
+	}
+	token, err := t.source.Token(ctx)
+	if err != nil {
+		return Token{}, err
+	}
+	t.token = token
+	return token, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	token, err := t.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(authorize(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	fresh, err := t.refresh(ctx, token)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to refresh the CloudConnexa API token after a 401 response", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return resp, nil
+	}
+	if fresh.AccessToken == token.AccessToken {
+		// The token source cannot issue a different token, e.g. a
+		// pre-issued access_token: the 401 is final.
+		return resp, nil
+	}
+	tflog.Debug(ctx, "Refreshed the CloudConnexa API token after a 401 response, replaying the request")
+
+	replay := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		replay.Body = body
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxLoggedBody))
+	_ = resp.Body.Close()
+	return t.next.RoundTrip(authorize(replay, fresh))
+}
+
+// authorize returns a copy of req carrying token as bearer credentials.
+func authorize(req *http.Request, token Token) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return req
+}
@@ -0,0 +1,270 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// maxCacheEntries bounds the number of responses kept by cacheTransport.
+const maxCacheEntries = 4096
+
+// cacheDependents lists, for each API collection, the other collections
+// whose responses embed or reference its objects and are therefore dropped
+// by a write to it: users embed their devices, deleting a user removes its
+// devices, and access groups and location contexts refer to user groups,
+// networks and hosts.
+var cacheDependents = map[string][]string{
+	"devices":     {"users"},
+	"users":       {"devices"},
+	"user-groups": {"users", "access-groups", "location-contexts"},
+	"networks":    {"access-groups"},
+	"hosts":       {"access-groups"},
+}
+
+// cacheEntry is a cached API response.
+type cacheEntry struct {
+	collection string
+	status     int
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+// cacheCall tracks a GET request in flight so identical concurrent requests
+// wait for its response instead of calling the API again.
+type cacheCall struct {
+	done  chan struct{}
+	entry *cacheEntry
+}
+
+// cacheTransport is an http.RoundTripper that keeps successful GET responses
+// in memory for ttl, keyed by URL, i.e. by endpoint and object ID. Any other
+// request, except the POSTs that only read data, drops the cached responses
+// of the collection it modifies, e.g. everything under /networks for a new
+// route, since networks embed their routes and connectors, plus those of the
+// collections listed in cacheDependents. It sits above retryTransport, so
+// cache hits neither consume the provider's rate limit nor reach the API.
+type cacheTransport struct {
+	next http.RoundTripper
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu       sync.Mutex
+	entries  map[string]*cacheEntry
+	inflight map[string]*cacheCall
+	// generation is incremented by every invalidation and recorded per
+	// invalidated collection in invalidated (or in invalidatedAll for a full
+	// flush), so responses to GETs that raced with a write are not cached.
+	generation     uint64
+	invalidated    map[string]uint64
+	invalidatedAll uint64
+}
+
+// noCacheKey is the context key set by withoutCache.
+type noCacheKey struct{}
+
+// withoutCache returns a context whose requests bypass the response cache:
+// GETs always reach the API, and their responses replace the cached ones so
+// later reads see the fresh data. It is used
+// when polling for a change made outside the provider, such as a connector
+// coming online.
+func withoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// newCacheTransport wraps next with a response cache of the given ttl.
+func newCacheTransport(next http.RoundTripper, ttl time.Duration) *cacheTransport {
+	return &cacheTransport{
+		next:        next,
+		ttl:         ttl,
+		now:         time.Now,
+		entries:     map[string]*cacheEntry{},
+		inflight:    map[string]*cacheCall{},
+		invalidated: map[string]uint64{},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	collection := apiCollection(req.URL.Path)
+	if req.Method != http.MethodGet {
+		if isReadOnlyRequest(req) {
+			return t.next.RoundTrip(req)
+		}
+		// Invalidate before the write so no later read is served stale data,
+		// and after it so reads that raced with the write are dropped too.
+		t.invalidate(collection)
+		defer t.invalidate(collection)
+		return t.next.RoundTrip(req)
+	}
+	if bypass, _ := req.Context().Value(noCacheKey{}).(bool); bypass {
+		t.mu.Lock()
+		generation := t.generation
+		t.mu.Unlock()
+		resp, err := t.next.RoundTrip(req)
+		if entry := t.store(req.URL.String(), collection, generation, resp, err); entry != nil {
+			return entry.response(req), nil
+		}
+		return resp, err
+	}
+
+	key := req.URL.String()
+	t.mu.Lock()
+	if e, ok := t.entries[key]; ok && t.now().Before(e.expires) {
+		t.mu.Unlock()
+		tflog.Debug(req.Context(), "Serving CloudConnexa API response from cache", map[string]interface{}{
+			"http_path": req.URL.Path,
+		})
+		return e.response(req), nil
+	}
+	if call, ok := t.inflight[key]; ok {
+		t.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		if call.entry != nil {
+			return call.entry.response(req), nil
+		}
+		// The shared request failed; let this caller try on its own.
+		return t.next.RoundTrip(req)
+	}
+	call := &cacheCall{done: make(chan struct{})}
+	t.inflight[key] = call
+	generation := t.generation
+	t.mu.Unlock()
+
+	resp, err := t.next.RoundTrip(req)
+	entry := t.store(key, collection, generation, resp, err)
+
+	t.mu.Lock()
+	call.entry = entry
+	delete(t.inflight, key)
+	t.mu.Unlock()
+	close(call.done)
+
+	if entry != nil {
+		return entry.response(req), nil
+	}
+	return resp, err
+}
+
+// store caches a successful response and returns its entry. Responses that
+// are not cacheable are returned to the caller untouched and yield nil.
+// Nothing is cached when collection was invalidated after generation.
+func (t *cacheTransport) store(key, collection string, generation uint64, resp *http.Response, err error) *cacheEntry {
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return nil
+	}
+	body, readErr := io.ReadAll(io.LimitReader(resp.Body, cloudconnexa.DefaultMaxResponseSize+1))
+	if readErr != nil || int64(len(body)) > cloudconnexa.DefaultMaxResponseSize {
+		// Hand the body back uncached so the go-client reports the read
+		// error or the oversized response itself.
+		resp.Body = &struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return nil
+	}
+	_ = resp.Body.Close()
+	entry := &cacheEntry{
+		collection: collection,
+		status:     resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		expires:    t.now().Add(t.ttl),
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.invalidated[collection] > generation || t.invalidatedAll > generation {
+		return entry
+	}
+	if len(t.entries) >= maxCacheEntries {
+		t.evictLocked()
+	}
+	t.entries[key] = entry
+	return entry
+}
+
+// evictLocked removes expired entries and, if the cache is still full, the
+// entry closest to expiry. t.mu must be held.
+func (t *cacheTransport) evictLocked() {
+	now := t.now()
+	var oldestKey string
+	var oldest time.Time
+	for k, e := range t.entries {
+		if !now.Before(e.expires) {
+			delete(t.entries, k)
+			continue
+		}
+		if oldestKey == "" || e.expires.Before(oldest) {
+			oldestKey, oldest = k, e.expires
+		}
+	}
+	if len(t.entries) >= maxCacheEntries {
+		delete(t.entries, oldestKey)
+	}
+}
+
+// invalidate drops the cached responses of collection and of the
+// collections depending on it. Requests outside the API collections drop
+// every cached response.
+func (t *cacheTransport) invalidate(collection string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.generation++
+	if collection == "" {
+		t.invalidatedAll = t.generation
+		clear(t.entries)
+		return
+	}
+	drop := map[string]bool{collection: true}
+	for _, c := range cacheDependents[collection] {
+		drop[c] = true
+	}
+	for c := range drop {
+		t.invalidated[c] = t.generation
+	}
+	for k, e := range t.entries {
+		if drop[e.collection] {
+			delete(t.entries, k)
+		}
+	}
+}
+
+// apiCollection returns the top-level API collection of path, e.g.
+// `networks` for /api/v1/networks/routes/{id}, or an empty string for paths
+// outside the API.
+func apiCollection(path string) string {
+	rest, ok := strings.CutPrefix(path, apiPathPrefix)
+	if !ok {
+		return ""
+	}
+	collection, _, _ := strings.Cut(rest, "/")
+	return collection
+}
+
+// response builds a new *http.Response for req from the cached entry.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
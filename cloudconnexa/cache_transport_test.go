@@ -0,0 +1,230 @@
+package cloudconnexa
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// countingServer returns a server that answers every request with its path
+// and the number of requests it has served for that path.
+func countingServer(t *testing.T, status int) (*httptest.Server, *sync.Map) {
+	t.Helper()
+	var counts sync.Map
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := counts.LoadOrStore(r.URL.Path, new(atomic.Int32))
+		n.(*atomic.Int32).Add(1)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	t.Cleanup(server.Close)
+	return server, &counts
+}
+
+// calls returns how often path was requested from a countingServer.
+func calls(counts *sync.Map, path string) int32 {
+	n, ok := counts.Load(path)
+	if !ok {
+		return 0
+	}
+	return n.(*atomic.Int32).Load()
+}
+
+// cachedGet issues a GET through hc and returns the response body.
+func cachedGet(t *testing.T, hc *http.Client, url string) string {
+	t.Helper()
+	resp, err := hc.Get(url)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+// TestUnitCacheTransport_HitAndExpiry checks that identical GETs are served
+// from the cache until the TTL elapses, and that IDs are cached separately.
+func TestUnitCacheTransport_HitAndExpiry(t *testing.T) {
+	server, counts := countingServer(t, http.StatusOK)
+	now := time.Now()
+	ct := newCacheTransport(http.DefaultTransport, time.Minute)
+	ct.now = func() time.Time { return now }
+	hc := &http.Client{Transport: ct}
+
+	assert.Equal(t, "/api/v1/networks/n1", cachedGet(t, hc, server.URL+"/api/v1/networks/n1"))
+	assert.Equal(t, "/api/v1/networks/n1", cachedGet(t, hc, server.URL+"/api/v1/networks/n1"))
+	cachedGet(t, hc, server.URL+"/api/v1/networks/n2")
+	assert.EqualValues(t, 1, calls(counts, "/api/v1/networks/n1"))
+	assert.EqualValues(t, 1, calls(counts, "/api/v1/networks/n2"))
+
+	now = now.Add(2 * time.Minute)
+	cachedGet(t, hc, server.URL+"/api/v1/networks/n1")
+	assert.EqualValues(t, 2, calls(counts, "/api/v1/networks/n1"))
+}
+
+// TestUnitCacheTransport_WriteInvalidates checks that a write drops the
+// cached responses of its collection and of the collections embedding its
+// objects, and keeps the others: a user group change must be visible when
+// reading users, a new route when reading its network.
+func TestUnitCacheTransport_WriteInvalidates(t *testing.T) {
+	server, counts := countingServer(t, http.StatusOK)
+	hc := &http.Client{Transport: newCacheTransport(http.DefaultTransport, time.Minute)}
+	paths := []string{"/api/v1/networks/n1", "/api/v1/users/u1", "/api/v1/dns-records/d1"}
+	for _, path := range paths {
+		cachedGet(t, hc, server.URL+path)
+	}
+
+	for _, path := range []string{"/api/v1/user-groups/g1", "/api/v1/networks/routes/r1"} {
+		req, _ := http.NewRequest(http.MethodPut, server.URL+path, strings.NewReader(`{}`))
+		resp, err := hc.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	for _, path := range paths {
+		cachedGet(t, hc, server.URL+path)
+	}
+	assert.EqualValues(t, 2, calls(counts, "/api/v1/networks/n1"))
+	assert.EqualValues(t, 2, calls(counts, "/api/v1/users/u1"))
+	assert.EqualValues(t, 1, calls(counts, "/api/v1/dns-records/d1"))
+}
+
+// TestUnitCacheTransport_ReadOnlyPostKeepsCache checks that fetching a
+// connector profile, which is a POST that only reads data, keeps the cached
+// GETs, even those of the connector itself.
+func TestUnitCacheTransport_ReadOnlyPostKeepsCache(t *testing.T) {
+	server, counts := countingServer(t, http.StatusOK)
+	hc := &http.Client{Transport: newCacheTransport(http.DefaultTransport, time.Minute)}
+	connector := server.URL + "/api/v1/networks/connectors/c1"
+	cachedGet(t, hc, connector)
+
+	for _, suffix := range readOnlyPostSuffixes {
+		resp, err := hc.Post(connector+suffix, "application/json", nil)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	cachedGet(t, hc, connector)
+	assert.EqualValues(t, 1, calls(counts, "/api/v1/networks/connectors/c1"))
+	assert.EqualValues(t, 1, calls(counts, "/api/v1/networks/connectors/c1/profile"))
+}
+
+// TestUnitCacheTransport_WithoutCache checks that GETs bound to a
+// withoutCache context always reach the API and refresh the cached response.
+func TestUnitCacheTransport_WithoutCache(t *testing.T) {
+	server, counts := countingServer(t, http.StatusOK)
+	hc := &http.Client{Transport: newCacheTransport(http.DefaultTransport, time.Minute)}
+	url := server.URL + "/api/v1/networks/connectors/c1"
+
+	cachedGet(t, hc, url)
+	for range 2 {
+		req, _ := http.NewRequestWithContext(withoutCache(context.Background()), http.MethodGet, url, nil)
+		resp, err := hc.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.EqualValues(t, 3, calls(counts, "/api/v1/networks/connectors/c1"))
+
+	cachedGet(t, hc, url)
+	assert.EqualValues(t, 3, calls(counts, "/api/v1/networks/connectors/c1"))
+}
+
+// TestUnitCacheTransport_ErrorsNotCached checks that failed responses always
+// reach the API again.
+func TestUnitCacheTransport_ErrorsNotCached(t *testing.T) {
+	server, counts := countingServer(t, http.StatusNotFound)
+	hc := &http.Client{Transport: newCacheTransport(http.DefaultTransport, time.Minute)}
+
+	cachedGet(t, hc, server.URL+"/api/v1/hosts/h1")
+	cachedGet(t, hc, server.URL+"/api/v1/hosts/h1")
+	assert.EqualValues(t, 2, calls(counts, "/api/v1/hosts/h1"))
+}
+
+// TestUnitCacheTransport_ConcurrentGets checks that identical concurrent
+// GETs share one API call.
+func TestUnitCacheTransport_ConcurrentGets(t *testing.T) {
+	var hits atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(server.Close)
+	hc := &http.Client{Transport: newCacheTransport(http.DefaultTransport, time.Minute)}
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 10)
+	for i := range bodies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			bodies[i] = cachedGet(t, hc, server.URL+"/api/v1/regions")
+		}(i)
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.EqualValues(t, 1, hits.Load())
+	for _, b := range bodies {
+		assert.Equal(t, `[]`, b)
+	}
+}
+
+// TestUnitCacheTransport_GoClient checks that repeated go-client lookups of
+// the same object are answered from the cache.
+func TestUnitCacheTransport_GoClient(t *testing.T) {
+	var hits atomic.Int32
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		_, _ = w.Write([]byte(`{"id":"host-id","name":"h"}`))
+	}))
+	c = c.WithHTTPClient(&http.Client{Transport: newCacheTransport(http.DefaultTransport, time.Minute)})
+
+	for range 2 {
+		h, err := c.Hosts.Get("host-id")
+		require.NoError(t, err)
+		assert.Equal(t, "h", h.Name)
+	}
+	assert.EqualValues(t, 1, hits.Load())
+}
+
+// TestUnitProviderConfigure_CacheTTL checks that responses are only cached
+// when `cache_ttl` is set.
+func TestUnitProviderConfigure_CacheTTL(t *testing.T) {
+	var hits atomic.Int32
+	server, certPEM := newTLSAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == tokenPath {
+			_, _ = w.Write([]byte(`{"access_token":"token"}`))
+			return
+		}
+		hits.Add(1)
+		_, _ = w.Write([]byte(`{"id":"host-id"}`))
+	}))
+
+	for ttl, want := range map[string]int32{"": 2, "1m": 1} {
+		hits.Store(0)
+		raw := map[string]interface{}{"ca_cert_pem": certPEM}
+		if ttl != "" {
+			raw["cache_ttl"] = ttl
+		}
+		c, diags := configureTestProvider(t, server.URL, raw)
+		require.False(t, diags.HasError(), "%v", diags)
+		c.ReadRateLimiter.SetLimit(rate.Inf)
+		for range 2 {
+			_, err := c.Hosts.Get("host-id")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, want, hits.Load(), "cache_ttl %q", ttl)
+	}
+}
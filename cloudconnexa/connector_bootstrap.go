@@ -0,0 +1,181 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// bootstrapTemplateVersion is the version of the bootstrap templates in use.
+// It is bumped, and the previous templates kept, whenever a change to the
+// rendered artifacts would alter already deployed connectors.
+const bootstrapTemplateVersion = "v1"
+
+// Formats of the cloudconnexa_connector_bootstrap data source.
+const (
+	bootstrapFormatCloudInit     = "cloud-init"
+	bootstrapFormatSystemd       = "systemd"
+	bootstrapFormatDockerCompose = "docker-compose"
+	bootstrapFormatKubernetes    = "kubernetes"
+)
+
+// bootstrapFormats lists every supported bootstrap format.
+var bootstrapFormats = []string{
+	bootstrapFormatCloudInit,
+	bootstrapFormatSystemd,
+	bootstrapFormatDockerCompose,
+	bootstrapFormatKubernetes,
+}
+
+//go:embed bootstrap
+var bootstrapFS embed.FS
+
+// bootstrapInput holds the values the bootstrap templates are rendered with.
+type bootstrapInput struct {
+	// Name names the rendered objects: the systemd unit, the compose
+	// service and the Kubernetes objects.
+	Name          string
+	ConnectorID   string
+	ConnectorName string
+	VpnRegionID   string
+	Profile       string
+	// Image is the container image running OpenVPN, for the container
+	// formats.
+	Image string
+	// Namespace is the Kubernetes namespace of the rendered objects.
+	Namespace string
+}
+
+// bootstrapArtifact is a rendered bootstrap format.
+type bootstrapArtifact struct {
+	// Content is the main document, e.g. the cloud-init user data or the
+	// Kubernetes manifest.
+	Content string
+	// Files maps the path of every file to deploy to its content, relative
+	// for the container formats and absolute for systemd.
+	Files map[string]string
+}
+
+// bootstrapFiles lists, per format, the files rendered by the templates of
+// bootstrapTemplateVersion, by output path. The first one is the main
+// document. Paths of the systemd format may reference {{.Name}}.
+var bootstrapFiles = map[string][][2]string{
+	bootstrapFormatCloudInit: {
+		{"user-data", "cloud-init.yaml.tmpl"},
+	},
+	bootstrapFormatSystemd: {
+		{"/etc/systemd/system/{{.Name}}.service", "systemd.service.tmpl"},
+		{"/etc/default/{{.Name}}", "systemd.env.tmpl"},
+		{"/etc/openvpn/cloudconnexa/{{.Name}}.ovpn", "profile.ovpn.tmpl"},
+		{"/etc/sysctl.d/90-{{.Name}}.conf", "sysctl.conf.tmpl"},
+	},
+	bootstrapFormatDockerCompose: {
+		{"docker-compose.yaml", "docker-compose.yaml.tmpl"},
+		{"connector.env", "docker-compose.env.tmpl"},
+		{"connector.ovpn", "profile.ovpn.tmpl"},
+	},
+	bootstrapFormatKubernetes: {
+		{"manifest.yaml", "kubernetes.yaml.tmpl"},
+	},
+}
+
+// bootstrapTemplates parses the templates of bootstrapTemplateVersion.
+// Besides indent, quote and version, templates can call `file` to embed the
+// output of another template, e.g. the systemd unit in the cloud-init user
+// data.
+func bootstrapTemplates() (*template.Template, error) {
+	var t *template.Template
+	t = template.New("bootstrap").Funcs(template.FuncMap{
+		"indent":  indentText,
+		"quote":   quoteYAML,
+		"version": func() string { return bootstrapTemplateVersion },
+		"file": func(name string, in bootstrapInput) (string, error) {
+			return executeBootstrapTemplate(t, name, in)
+		},
+	})
+	return t.ParseFS(bootstrapFS, "bootstrap/"+bootstrapTemplateVersion+"/*.tmpl")
+}
+
+// executeBootstrapTemplate renders the template called name with in.
+func executeBootstrapTemplate(t *template.Template, name string, in bootstrapInput) (string, error) {
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, name, in); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// renderBootstrap renders the artifacts of format for in.
+//
+// Parameters:
+//   - format: One of bootstrapFormats
+//   - in: The connector and deployment settings to render
+//
+// Returns:
+//   - bootstrapArtifact: The rendered main document and files
+//   - error: An error if the format is unknown, lacks an input or fails to render
+func renderBootstrap(format string, in bootstrapInput) (bootstrapArtifact, error) {
+	files, ok := bootstrapFiles[format]
+	if !ok {
+		return bootstrapArtifact{}, fmt.Errorf("unknown bootstrap format %q", format)
+	}
+	if in.Image == "" && (format == bootstrapFormatDockerCompose || format == bootstrapFormatKubernetes) {
+		return bootstrapArtifact{}, fmt.Errorf("`image` is required for the %s format", format)
+	}
+	t, err := bootstrapTemplates()
+	if err != nil {
+		return bootstrapArtifact{}, err
+	}
+	artifact := bootstrapArtifact{Files: map[string]string{}}
+	for i, f := range files {
+		path := strings.ReplaceAll(f[0], "{{.Name}}", in.Name)
+		content, err := executeBootstrapTemplate(t, f[1], in)
+		if err != nil {
+			return bootstrapArtifact{}, fmt.Errorf("rendering %s: %w", path, err)
+		}
+		if i == 0 {
+			artifact.Content = content
+		}
+		artifact.Files[path] = content
+	}
+	return artifact, nil
+}
+
+// invalidBootstrapNameChars matches the characters not allowed in a
+// Kubernetes object name.
+var invalidBootstrapNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// bootstrapName derives the default name of the rendered objects from the
+// connector name: a DNS label usable as a systemd unit, compose service and
+// Kubernetes object name.
+func bootstrapName(connectorName string) string {
+	name := invalidBootstrapNameChars.ReplaceAllString(strings.ToLower(connectorName), "-")
+	name = strings.Trim("cloudconnexa-"+name, "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
+
+// indentText prefixes every non-empty line of s with n spaces, for embedding
+// multi-line values in YAML block scalars.
+func indentText(n int, s string) string {
+	pad := strings.Repeat(" ", n)
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	for i, l := range lines {
+		if l != "" {
+			lines[i] = pad + l
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// quoteYAML returns s as a double-quoted YAML scalar.
+func quoteYAML(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
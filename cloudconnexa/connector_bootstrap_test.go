@@ -0,0 +1,159 @@
+package cloudconnexa
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// updateGolden rewrites the golden files under testdata instead of comparing
+// against them: go test ./cloudconnexa/ -run Golden -update
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+// goldenBootstrapInput is the fixed input the golden files are rendered from.
+var goldenBootstrapInput = bootstrapInput{
+	Name:          "cloudconnexa-office-1",
+	ConnectorID:   "00000000-0000-4000-8000-000000000001",
+	ConnectorName: "Office 1",
+	VpnRegionID:   "eu-west-1",
+	Profile:       "client\ndev tun\nremote eu-west-1.example.openvpn.com 1194 udp\n<ca>\n-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n</ca>\n",
+	Image:         "registry.example.com/openvpn:2.6",
+	Namespace:     "vpn",
+}
+
+// goldenText joins the files of a rendered artifact into one document, in
+// path order, each preceded by a `==> path <==` header.
+func goldenText(a bootstrapArtifact) string {
+	paths := make([]string, 0, len(a.Files))
+	for p := range a.Files {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	var b strings.Builder
+	for _, p := range paths {
+		b.WriteString("==> " + p + " <==\n")
+		b.WriteString(a.Files[p])
+		if !strings.HasSuffix(a.Files[p], "\n") {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func TestUnitBootstrapGolden(t *testing.T) {
+	for _, format := range bootstrapFormats {
+		t.Run(format, func(t *testing.T) {
+			artifact, err := renderBootstrap(format, goldenBootstrapInput)
+			require.NoError(t, err)
+			assert.Contains(t, artifact.Files, mainBootstrapPath(format))
+			assert.Equal(t, artifact.Files[mainBootstrapPath(format)], artifact.Content)
+
+			path := filepath.Join("testdata", "bootstrap", bootstrapTemplateVersion, format+".golden")
+			got := goldenText(artifact)
+			if *updateGolden {
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+				require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+			}
+			want, err := os.ReadFile(path)
+			require.NoError(t, err, "run with -update to create the golden file")
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
+
+// mainBootstrapPath returns the path of the main document of format.
+func mainBootstrapPath(format string) string {
+	return strings.ReplaceAll(bootstrapFiles[format][0][0], "{{.Name}}", goldenBootstrapInput.Name)
+}
+
+func TestUnitBootstrapYAMLIsValid(t *testing.T) {
+	in := goldenBootstrapInput
+	in.Profile = "client\n# comment: with \"quotes\"\n  indented\n\nlast"
+	in.ConnectorID = `i"d: #1`
+	for format, path := range map[string]string{
+		bootstrapFormatCloudInit:     "user-data",
+		bootstrapFormatDockerCompose: "docker-compose.yaml",
+		bootstrapFormatKubernetes:    "manifest.yaml",
+	} {
+		t.Run(format, func(t *testing.T) {
+			artifact, err := renderBootstrap(format, in)
+			require.NoError(t, err)
+			dec := yaml.NewDecoder(strings.NewReader(artifact.Files[path]))
+			var docs []map[string]interface{}
+			for {
+				var doc map[string]interface{}
+				if err := dec.Decode(&doc); err != nil {
+					break
+				}
+				docs = append(docs, doc)
+			}
+			require.NotEmpty(t, docs)
+			if format == bootstrapFormatKubernetes {
+				require.Len(t, docs, 2)
+				data := docs[0]["stringData"].(map[string]interface{})
+				assert.Equal(t, in.Profile+"\n", data["connector.ovpn"])
+				assert.Equal(t, in.ConnectorID, data["CLOUDCONNEXA_CONNECTOR_ID"])
+			}
+			if format == bootstrapFormatCloudInit {
+				files := docs[0]["write_files"].([]interface{})
+				assert.Equal(t, in.Profile+"\n", files[0].(map[string]interface{})["content"])
+			}
+		})
+	}
+}
+
+func TestUnitBootstrapErrors(t *testing.T) {
+	_, err := renderBootstrap("ansible", goldenBootstrapInput)
+	assert.ErrorContains(t, err, `unknown bootstrap format "ansible"`)
+
+	in := goldenBootstrapInput
+	in.Image = ""
+	_, err = renderBootstrap(bootstrapFormatKubernetes, in)
+	assert.ErrorContains(t, err, "`image` is required for the kubernetes format")
+	_, err = renderBootstrap(bootstrapFormatSystemd, in)
+	assert.NoError(t, err)
+}
+
+func TestUnitBootstrapName(t *testing.T) {
+	assert.Equal(t, "cloudconnexa-office-1", bootstrapName("Office 1"))
+	assert.Equal(t, "cloudconnexa-eu-west-gw", bootstrapName("EU_West (GW)"))
+	assert.Len(t, bootstrapName(strings.Repeat("a", 100)), 63)
+}
+
+func TestUnitFakeAPIConnectorBootstrap(t *testing.T) {
+	_, p := newFakeAPIProvider(t, fakeapi.Options{})
+	ctx := context.Background()
+	c := clientFromMeta(ctx, p.Meta())
+	host, err := c.Hosts.Create(cloudconnexa.Host{Name: "web", InternetAccess: "SPLIT_TUNNEL_ON"})
+	require.NoError(t, err)
+	connector, err := c.HostConnectors.Create(cloudconnexa.HostConnector{Name: "Web GW", VpnRegionID: "us-east-1", NetworkItemID: host.ID, NetworkItemType: "HOST"}, host.ID)
+	require.NoError(t, err)
+
+	ds := p.DataSourcesMap["cloudconnexa_connector_bootstrap"]
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"host_connector_id": connector.ID,
+		"format":            "systemd",
+	})
+	diags := ds.ReadContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, connector.ID+"/systemd", d.Id())
+	assert.Equal(t, "cloudconnexa-web-gw", d.Get("name"))
+	assert.Equal(t, bootstrapTemplateVersion, d.Get("template_version"))
+	files := d.Get("files").(map[string]interface{})
+	assert.Len(t, files, 4)
+	assert.Contains(t, files["/etc/default/cloudconnexa-web-gw"], "CLOUDCONNEXA_CONNECTOR_ID="+connector.ID)
+	assert.NotContains(t, files["/etc/default/cloudconnexa-web-gw"], "fake-connector-token")
+	assert.Contains(t, files["/etc/openvpn/cloudconnexa/cloudconnexa-web-gw.ovpn"], "remote us-east-1.fake.openvpn.com")
+	assert.Contains(t, d.Get("content"), "EnvironmentFile=/etc/default/cloudconnexa-web-gw")
+}
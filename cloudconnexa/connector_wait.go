@@ -0,0 +1,124 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// connectorStatusOnline is the `wait_for_status` value that waits for a
+// connector to connect. The API reports it in lower case.
+const connectorStatusOnline = "ONLINE"
+
+// defaultConnectorWaitTimeout is used when `wait_timeout` is not set.
+const defaultConnectorWaitTimeout = 10 * time.Minute
+
+// connectorWaitMinBackoff and connectorWaitMaxBackoff bound the interval
+// between two connection status checks.
+var (
+	connectorWaitMinBackoff = 2 * time.Second
+	connectorWaitMaxBackoff = 30 * time.Second
+)
+
+// connectorWaitSchema returns the `wait_for_status` and `wait_timeout`
+// attributes shared by the network and host connector resources.
+func connectorWaitSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"wait_for_status": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{connectorStatusOnline}, false),
+			Description: "If set to `ONLINE`, create and update wait until the connector reports that it is connected, " +
+				"so resources depending on it do not race the tunnel coming up. The host running the connector must be " +
+				"provisioned without depending on this resource, otherwise Terraform only creates it after the wait times out.",
+		},
+		"wait_timeout": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      defaultConnectorWaitTimeout.String(),
+			ValidateFunc: validateDuration,
+			Description:  fmt.Sprintf("How long to wait for `wait_for_status`, as a Go duration string. Defaults to `%s`.", defaultConnectorWaitTimeout),
+		},
+	}
+}
+
+// connectorStatusFunc returns the connection status of the connector with
+// the given ID.
+type connectorStatusFunc func(c *cloudconnexa.Client, id string) (string, error)
+
+// networkConnectorStatus is the connectorStatusFunc of network connectors.
+func networkConnectorStatus(c *cloudconnexa.Client, id string) (string, error) {
+	connector, err := c.NetworkConnectors.GetByID(id)
+	if err != nil {
+		return "", err
+	}
+	return connector.ConnectionStatus, nil
+}
+
+// hostConnectorStatus is the connectorStatusFunc of host connectors.
+func hostConnectorStatus(c *cloudconnexa.Client, id string) (string, error) {
+	connector, err := c.HostConnectors.GetByID(id)
+	if err != nil {
+		return "", err
+	}
+	return connector.ConnectionStatus, nil
+}
+
+// waitForConnectorStatus polls the connector of d with exponential backoff
+// until it reports `wait_for_status`, bypassing the response cache. It
+// returns at once when `wait_for_status` is unset or the connector is
+// suspended, since a suspended connector never comes online.
+//
+// Parameters:
+//   - ctx: The context of the current CRUD operation
+//   - d: The Terraform resource data of the connector
+//   - m: The provider meta interface
+//   - status: Looks up the connection status of the connector
+//
+// Returns:
+//   - string: The last connection status seen, empty if nothing was polled
+//   - error: An error if the lookup failed or the wait timed out
+func waitForConnectorStatus(ctx context.Context, d *schema.ResourceData, m interface{}, status connectorStatusFunc) (string, error) {
+	want := d.Get("wait_for_status").(string)
+	if want == "" || d.Get("status").(string) == "SUSPENDED" {
+		return "", nil
+	}
+	timeout, err := time.ParseDuration(d.Get("wait_timeout").(string))
+	if err != nil {
+		return "", fmt.Errorf("invalid wait_timeout: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := clientFromMeta(withoutCache(ctx), m)
+
+	wait := connectorWaitMinBackoff
+	var got string
+	for {
+		got, err = status(c, d.Id())
+		if err != nil && ctx.Err() == nil {
+			return got, err
+		}
+		if err == nil && strings.EqualFold(got, want) {
+			return got, nil
+		}
+		tflog.Debug(ctx, "Waiting for connector status", map[string]interface{}{
+			"id":     d.Id(),
+			"status": got,
+			"want":   want,
+		})
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return got, fmt.Errorf("connector %s did not become %s within %s (last status %q)", d.Id(), want, timeout, got)
+		}
+		wait = min(2*wait, connectorWaitMaxBackoff)
+	}
+}
@@ -0,0 +1,83 @@
+package cloudconnexa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastConnectorWait shortens the polling interval of waitForConnectorStatus
+// for the duration of t.
+func fastConnectorWait(t *testing.T) {
+	minBackoff, maxBackoff := connectorWaitMinBackoff, connectorWaitMaxBackoff
+	connectorWaitMinBackoff, connectorWaitMaxBackoff = 10*time.Millisecond, 50*time.Millisecond
+	t.Cleanup(func() { connectorWaitMinBackoff, connectorWaitMaxBackoff = minBackoff, maxBackoff })
+}
+
+func TestUnitConnectorWaitForOnline(t *testing.T) {
+	fastConnectorWait(t)
+	srv, p := newFakeAPIProvider(t, fakeapi.Options{ConnectorOnlineAfter: 300 * time.Millisecond})
+	ctx := context.Background()
+	c := clientFromMeta(ctx, p.Meta())
+	network, err := c.Networks.Create(cloudconnexa.Network{Name: "office", InternetAccess: "SPLIT_TUNNEL_ON"})
+	require.NoError(t, err)
+	connector := p.ResourcesMap["cloudconnexa_network_connector"]
+
+	d := schema.TestResourceDataRaw(t, connector.Schema, map[string]interface{}{
+		"name":            "office-1",
+		"vpn_region_id":   "eu-west-1",
+		"network_id":      network.ID,
+		"wait_for_status": "ONLINE",
+	})
+	start := time.Now()
+	diags := connector.CreateContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, diags, "no manual setup warning once the connector is online")
+	assert.GreaterOrEqual(t, time.Since(start), 300*time.Millisecond)
+	assert.Equal(t, "online", d.Get("connection_status"))
+
+	// Polling is not served from the response cache.
+	requests := srv.Requests()
+	_, err = waitForConnectorStatus(ctx, d, p.Meta(), networkConnectorStatus)
+	require.NoError(t, err)
+	assert.Greater(t, srv.Requests(), requests)
+}
+
+func TestUnitConnectorWaitTimeout(t *testing.T) {
+	fastConnectorWait(t)
+	_, p := newFakeAPIProvider(t, fakeapi.Options{ConnectorOnlineAfter: time.Hour})
+	ctx := context.Background()
+	c := clientFromMeta(ctx, p.Meta())
+	host, err := c.Hosts.Create(cloudconnexa.Host{Name: "web", InternetAccess: "SPLIT_TUNNEL_ON"})
+	require.NoError(t, err)
+	connector := p.ResourcesMap["cloudconnexa_host_connector"]
+
+	d := schema.TestResourceDataRaw(t, connector.Schema, map[string]interface{}{
+		"name":            "web-1",
+		"vpn_region_id":   "us-east-1",
+		"host_id":         host.ID,
+		"wait_for_status": "ONLINE",
+		"wait_timeout":    "200ms",
+	})
+	diags := connector.CreateContext(ctx, d, p.Meta())
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, `did not become ONLINE within 200ms (last status "offline")`)
+	assert.NotEmpty(t, d.Id(), "the connector is kept in state to be tainted")
+
+	// Suspended connectors never come online, so there is nothing to wait for.
+	d = schema.TestResourceDataRaw(t, connector.Schema, map[string]interface{}{
+		"name":            "web-2",
+		"vpn_region_id":   "us-east-1",
+		"host_id":         host.ID,
+		"status":          "SUSPENDED",
+		"wait_for_status": "ONLINE",
+	})
+	diags = connector.CreateContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+}
@@ -0,0 +1,330 @@
+package cloudconnexa
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ProfileEnvVar is the environment variable name for the credentials file profile
+const ProfileEnvVar = "CLOUDCONNEXA_PROFILE"
+
+// CredentialsFileEnvVar is the environment variable name for the credentials file location
+const CredentialsFileEnvVar = "CLOUDCONNEXA_CREDENTIALS_FILE"
+
+// defaultProfileName is the profile used when neither `profile` nor
+// CLOUDCONNEXA_PROFILE is set.
+const defaultProfileName = "default"
+
+// defaultCredentialsFile returns ~/.cloudconnexa/credentials, or an empty
+// string if the home directory cannot be determined.
+func defaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".cloudconnexa", "credentials")
+}
+
+// providerCredentials holds the connection settings the provider needs to
+// authenticate against one CloudConnexa tenant.
+type providerCredentials struct {
+	CloudID      string `json:"cloud_id"`
+	BaseURL      string `json:"base_url"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// credentialsProfile is one named section of the credentials file.
+type credentialsProfile struct {
+	providerCredentials
+	CredentialProcess string
+}
+
+// mergeMissing fills every empty field of c from other.
+func (c *providerCredentials) mergeMissing(other providerCredentials) {
+	if c.CloudID == "" && c.BaseURL == "" {
+		c.CloudID = other.CloudID
+		c.BaseURL = other.BaseURL
+	}
+	if c.ClientID == "" {
+		c.ClientID = other.ClientID
+	}
+	if c.ClientSecret == "" {
+		c.ClientSecret = other.ClientSecret
+	}
+}
+
+// baseURL returns the API base URL, deriving it from the cloud ID when no
+// explicit base URL is set.
+func (c providerCredentials) baseURL() (string, error) {
+	if c.BaseURL != "" {
+		return c.BaseURL, nil
+	}
+	if c.CloudID == "" {
+		return "", nil
+	}
+	if !cloudIDPattern.MatchString(c.CloudID) {
+		return "", errors.New("invalid cloud_id format: must contain only alphanumeric characters and hyphens")
+	}
+	return "https://" + c.CloudID + ".api.openvpn.com", nil
+}
+
+// resolveCredentials combines the provider block (whose client_id and
+// client_secret already include their environment variable defaults) with a
+// profile from the credentials file. A profile selected with `profile` or
+// CLOUDCONNEXA_PROFILE is used whole: explicit values may only fill settings
+// it leaves empty, and differing values are an error so that credentials of
+// one tenant are never sent to another. The default profile only fills the
+// gaps of the explicit values. Within a profile, values printed by
+// credential_process override the static keys.
+//
+// Parameters:
+//   - ctx: The context used to run credential_process
+//   - explicit: Settings from the provider block and environment
+//   - profile: The requested profile name; empty selects the default profile
+//   - file: The credentials file path; empty selects the default location
+//
+// Returns:
+//   - providerCredentials: The merged settings
+//   - error: An error if an explicitly requested profile or file cannot be
+//     used, explicit values conflict with an explicitly requested profile,
+//     or an explicitly set tenant lacks client credentials the default
+//     profile only holds for another tenant
+func resolveCredentials(ctx context.Context, explicit providerCredentials, profile, file string) (providerCredentials, error) {
+	resolved := explicit
+	explicitProfile := profile != ""
+	if !explicitProfile {
+		profile = defaultProfileName
+	}
+	explicitFile := file != ""
+	if !explicitFile {
+		file = defaultCredentialsFile()
+	}
+	if resolved.complete() && !explicitProfile {
+		return resolved, nil
+	}
+	if file == "" {
+		return resolved, nil
+	}
+
+	profiles, err := loadCredentialsFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicitFile && !explicitProfile {
+			return resolved, nil
+		}
+		return resolved, fmt.Errorf("failed to read credentials file %s: %w", file, err)
+	}
+	p, ok := profiles[profile]
+	if !ok {
+		if !explicitProfile {
+			return resolved, nil
+		}
+		return resolved, fmt.Errorf("profile %q not found in credentials file %s", profile, file)
+	}
+
+	fromProfile := p.providerCredentials
+	if p.CredentialProcess != "" {
+		processed, err := runCredentialProcess(ctx, p.CredentialProcess)
+		if err != nil {
+			return resolved, fmt.Errorf("credential_process for profile %q failed: %w", profile, err)
+		}
+		if processed.BaseURL != "" || processed.CloudID != "" {
+			fromProfile.BaseURL, fromProfile.CloudID = "", ""
+		}
+		processed.mergeMissing(fromProfile)
+		fromProfile = processed
+	}
+	if explicitProfile {
+		if err := checkProfileConflicts(explicit, fromProfile); err != nil {
+			return resolved, fmt.Errorf("profile %q: %w", profile, err)
+		}
+		fromProfile.mergeMissing(explicit)
+		return fromProfile, nil
+	}
+	if !explicit.sameTenant(fromProfile) {
+		if missing := explicit.missingClientCredentials(); len(missing) > 0 {
+			return resolved, fmt.Errorf("%s must be set for %s: the default profile holds the credentials of %s",
+				strings.Join(missing, " and "), explicit.tenant(), fromProfile.tenant())
+		}
+		return resolved, nil
+	}
+	resolved.mergeMissing(fromProfile)
+	return resolved, nil
+}
+
+// sameTenant reports whether c and other may be combined: either of them
+// names no tenant, or both resolve to the same API base URL.
+func (c providerCredentials) sameTenant(other providerCredentials) bool {
+	if c.CloudID == "" && c.BaseURL == "" || other.CloudID == "" && other.BaseURL == "" {
+		return true
+	}
+	a, errA := c.baseURL()
+	b, errB := other.baseURL()
+	return errA == nil && errB == nil && strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
+
+// tenant describes the tenant c points at, for error messages.
+func (c providerCredentials) tenant() string {
+	if c.BaseURL != "" {
+		return fmt.Sprintf("base_url %q", c.BaseURL)
+	}
+	return fmt.Sprintf("cloud_id %q", c.CloudID)
+}
+
+// missingClientCredentials returns the names of the client credential
+// arguments that are not set.
+func (c providerCredentials) missingClientCredentials() []string {
+	var missing []string
+	if c.ClientID == "" {
+		missing = append(missing, "client_id")
+	}
+	if c.ClientSecret == "" {
+		missing = append(missing, "client_secret")
+	}
+	return missing
+}
+
+// checkProfileConflicts returns an error naming every setting that is set
+// both explicitly and in the profile to different values. cloud_id and
+// base_url are compared as one setting, the tenant.
+func checkProfileConflicts(explicit, profile providerCredentials) error {
+	var errs []error
+	if (explicit.CloudID != "" || explicit.BaseURL != "") && (profile.CloudID != "" || profile.BaseURL != "") &&
+		(explicit.CloudID != profile.CloudID || explicit.BaseURL != profile.BaseURL) {
+		errs = append(errs, errors.New("cloud_id/base_url in the provider block differs from the profile"))
+	}
+	if explicit.ClientID != "" && profile.ClientID != "" && explicit.ClientID != profile.ClientID {
+		errs = append(errs, fmt.Errorf("client_id from the provider block or %s differs from the profile", ClientIDEnvVar))
+	}
+	if explicit.ClientSecret != "" && profile.ClientSecret != "" && explicit.ClientSecret != profile.ClientSecret {
+		errs = append(errs, fmt.Errorf("client_secret from the provider block or %s differs from the profile", ClientSecretEnvVar))
+	}
+	return errors.Join(errs...)
+}
+
+// complete reports whether every setting needed to connect is already known.
+func (c providerCredentials) complete() bool {
+	return c.ClientID != "" && c.ClientSecret != "" && (c.BaseURL != "" || c.CloudID != "")
+}
+
+// loadCredentialsFile reads the credentials file at path.
+func loadCredentialsFile(path string) (map[string]credentialsProfile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return parseCredentials(f)
+}
+
+// parseCredentials parses an INI-style credentials file. The format is also
+// a valid subset of TOML, so both styles of quoting are accepted:
+//
+//	[default]
+//	cloud_id      = "acme"
+//	client_id     = "..."
+//	client_secret = "..."
+//
+//	[staging]
+//	base_url           = https://acme-staging.api.openvpn.com
+//	credential_process = vault-cloudconnexa staging
+//
+// Lines starting with `#` or `;` are comments. Unknown keys are rejected so
+// typos do not silently fall back to other credentials.
+func parseCredentials(r io.Reader) (map[string]credentialsProfile, error) {
+	profiles := make(map[string]credentialsProfile)
+	var current string
+	s := bufio.NewScanner(r)
+	for lineNo := 1; s.Scan(); lineNo++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimPrefix(strings.TrimSpace(line[1:len(line)-1]), "profile ")
+			current = strings.Trim(strings.TrimSpace(current), `"'`)
+			if current == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNo)
+			}
+			if _, ok := profiles[current]; !ok {
+				profiles[current] = credentialsProfile{}
+			}
+			continue
+		}
+		if current == "" {
+			return nil, fmt.Errorf("line %d: key outside of a [profile] section", lineNo)
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		key = strings.TrimSpace(key)
+		value = unquoteCredentialValue(strings.TrimSpace(value))
+
+		p := profiles[current]
+		switch key {
+		case "cloud_id":
+			p.CloudID = value
+		case "base_url":
+			p.BaseURL = value
+		case "client_id":
+			p.ClientID = value
+		case "client_secret":
+			p.ClientSecret = value
+		case "credential_process":
+			p.CredentialProcess = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q in profile %q", lineNo, key, current)
+		}
+		profiles[current] = p
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// unquoteCredentialValue strips matching single or double quotes.
+func unquoteCredentialValue(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		return v[1 : len(v)-1]
+	}
+	return v
+}
+
+// runCredentialProcess runs command through the system shell and decodes
+// the JSON object it prints on stdout, for example:
+//
+//	{"client_id": "...", "client_secret": "...", "cloud_id": "acme"}
+func runCredentialProcess(ctx context.Context, command string) (providerCredentials, error) {
+	var creds providerCredentials
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return creds, fmt.Errorf("%w: %s", err, msg)
+		}
+		return creds, err
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return creds, fmt.Errorf("invalid JSON output: %w", err)
+	}
+	return creds, nil
+}
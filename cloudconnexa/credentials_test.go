@@ -0,0 +1,207 @@
+package cloudconnexa
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCredentialsFile writes content to a temporary credentials file and
+// returns its path.
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+const testCredentialsFile = `
+# shared tenants
+[default]
+cloud_id      = "acme"
+client_id     = "default-id"
+client_secret = "default-secret"
+
+[staging]
+base_url      = 'https://acme-staging.api.openvpn.com'
+client_id     = staging-id
+client_secret = staging-secret
+
+[partial]
+cloud_id = partial-cloud
+`
+
+// TestUnitParseCredentials covers INI and TOML style quoting, comments and
+// every supported key.
+func TestUnitParseCredentials(t *testing.T) {
+	profiles, err := parseCredentials(strings.NewReader(testCredentialsFile + `
+[profile "process"]
+credential_process = "echo {}"
+`))
+	require.NoError(t, err)
+	require.Len(t, profiles, 4)
+	assert.Equal(t, providerCredentials{CloudID: "acme", ClientID: "default-id", ClientSecret: "default-secret"}, profiles["default"].providerCredentials)
+	assert.Equal(t, "https://acme-staging.api.openvpn.com", profiles["staging"].BaseURL)
+	assert.Equal(t, "staging-id", profiles["staging"].ClientID)
+	assert.Equal(t, "echo {}", profiles["process"].CredentialProcess)
+}
+
+// TestUnitParseCredentials_Errors checks that malformed files are rejected
+// instead of silently ignored.
+func TestUnitParseCredentials_Errors(t *testing.T) {
+	for name, content := range map[string]string{
+		"key outside profile": "client_id = x\n",
+		"missing equals":      "[default]\nclient_id\n",
+		"unknown key":         "[default]\nclient_idd = x\n",
+		"empty profile name":  "[]\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseCredentials(strings.NewReader(content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestUnitResolveCredentials_Precedence covers the precedence rules between
+// the provider block/environment, the selected profile and the defaults.
+func TestUnitResolveCredentials_Precedence(t *testing.T) {
+	file := writeCredentialsFile(t, testCredentialsFile)
+	ctx := context.Background()
+
+	t.Run("default profile fills everything", func(t *testing.T) {
+		got, err := resolveCredentials(ctx, providerCredentials{}, "", file)
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{CloudID: "acme", ClientID: "default-id", ClientSecret: "default-secret"}, got)
+	})
+
+	t.Run("named profile is selected", func(t *testing.T) {
+		got, err := resolveCredentials(ctx, providerCredentials{}, "staging", file)
+		require.NoError(t, err)
+		assert.Equal(t, "https://acme-staging.api.openvpn.com", got.BaseURL)
+		assert.Equal(t, "staging-id", got.ClientID)
+	})
+
+	t.Run("explicit values win over the default profile", func(t *testing.T) {
+		got, err := resolveCredentials(ctx, providerCredentials{ClientSecret: "hcl-secret"}, "", file)
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{CloudID: "acme", ClientID: "default-id", ClientSecret: "hcl-secret"}, got)
+
+		got, err = resolveCredentials(ctx, providerCredentials{ClientSecret: "hcl-secret", BaseURL: "https://acme.api.openvpn.com"}, "", file)
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{BaseURL: "https://acme.api.openvpn.com", ClientID: "default-id", ClientSecret: "hcl-secret"}, got)
+	})
+
+	t.Run("default profile credentials are not sent to another tenant", func(t *testing.T) {
+		_, err := resolveCredentials(ctx, providerCredentials{ClientSecret: "hcl-secret", CloudID: "hcl-cloud"}, "", file)
+		assert.EqualError(t, err, `client_id must be set for cloud_id "hcl-cloud": the default profile holds the credentials of cloud_id "acme"`)
+
+		_, err = resolveCredentials(ctx, providerCredentials{BaseURL: "https://x.api.openvpn.com"}, "", file)
+		assert.EqualError(t, err, `client_id and client_secret must be set for base_url "https://x.api.openvpn.com": the default profile holds the credentials of cloud_id "acme"`)
+	})
+
+	t.Run("explicit values conflicting with a selected profile are an error", func(t *testing.T) {
+		_, err := resolveCredentials(ctx, providerCredentials{ClientID: "env-id", ClientSecret: "env-secret"}, "staging", file)
+		assert.ErrorContains(t, err, `profile "staging": client_id from the provider block or CLOUDCONNEXA_CLIENT_ID differs from the profile`)
+		assert.ErrorContains(t, err, "client_secret from the provider block or CLOUDCONNEXA_CLIENT_SECRET differs")
+
+		_, err = resolveCredentials(ctx, providerCredentials{CloudID: "hcl-cloud"}, "staging", file)
+		assert.ErrorContains(t, err, "cloud_id/base_url in the provider block differs from the profile")
+	})
+
+	t.Run("selected profile is used whole", func(t *testing.T) {
+		got, err := resolveCredentials(ctx, providerCredentials{ClientID: "staging-id"}, "staging", file)
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{BaseURL: "https://acme-staging.api.openvpn.com", ClientID: "staging-id", ClientSecret: "staging-secret"}, got)
+
+		got, err = resolveCredentials(ctx, providerCredentials{ClientID: "id", ClientSecret: "secret"}, "partial", file)
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{CloudID: "partial-cloud", ClientID: "id", ClientSecret: "secret"}, got)
+	})
+
+	t.Run("explicit base_url suppresses profile cloud_id", func(t *testing.T) {
+		got, err := resolveCredentials(ctx, providerCredentials{BaseURL: "https://acme.api.openvpn.com/"}, "", file)
+		require.NoError(t, err)
+		assert.Equal(t, "https://acme.api.openvpn.com/", got.BaseURL)
+		assert.Empty(t, got.CloudID)
+	})
+
+	t.Run("complete explicit settings skip the file", func(t *testing.T) {
+		explicit := providerCredentials{BaseURL: "https://x.api.openvpn.com", ClientID: "id", ClientSecret: "secret"}
+		got, err := resolveCredentials(ctx, explicit, "", filepath.Join(t.TempDir(), "broken"))
+		require.NoError(t, err)
+		assert.Equal(t, explicit, got)
+	})
+
+	t.Run("partial profile leaves gaps", func(t *testing.T) {
+		got, err := resolveCredentials(ctx, providerCredentials{}, "partial", file)
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{CloudID: "partial-cloud"}, got)
+	})
+
+	t.Run("unknown explicit profile is an error", func(t *testing.T) {
+		_, err := resolveCredentials(ctx, providerCredentials{}, "missing", file)
+		assert.ErrorContains(t, err, `profile "missing" not found`)
+	})
+
+	t.Run("missing default file is ignored", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		got, err := resolveCredentials(ctx, providerCredentials{ClientID: "id"}, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, providerCredentials{ClientID: "id"}, got)
+	})
+
+	t.Run("missing explicit file is an error", func(t *testing.T) {
+		_, err := resolveCredentials(ctx, providerCredentials{}, "", filepath.Join(t.TempDir(), "nope"))
+		assert.Error(t, err)
+	})
+}
+
+// TestUnitResolveCredentials_CredentialProcess checks that values printed by
+// credential_process override the profile's static keys and are checked
+// against explicit provider settings.
+func TestUnitResolveCredentials_CredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test uses a POSIX shell")
+	}
+	file := writeCredentialsFile(t, `
+[proc]
+cloud_id           = static-cloud
+client_id          = static-id
+credential_process = printf '{"client_id":"proc-id","client_secret":"proc-secret"}'
+
+[failing]
+credential_process = echo boom >&2; exit 3
+`)
+	ctx := context.Background()
+
+	got, err := resolveCredentials(ctx, providerCredentials{}, "proc", file)
+	require.NoError(t, err)
+	assert.Equal(t, providerCredentials{CloudID: "static-cloud", ClientID: "proc-id", ClientSecret: "proc-secret"}, got)
+
+	_, err = resolveCredentials(ctx, providerCredentials{ClientID: "hcl-id"}, "proc", file)
+	assert.ErrorContains(t, err, "client_id from the provider block")
+
+	_, err = resolveCredentials(ctx, providerCredentials{}, "failing", file)
+	assert.ErrorContains(t, err, "boom")
+}
+
+// TestUnitProviderCredentialsBaseURL covers cloud_id expansion and
+// validation.
+func TestUnitProviderCredentialsBaseURL(t *testing.T) {
+	u, err := providerCredentials{CloudID: "acme"}.baseURL()
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.api.openvpn.com", u)
+
+	u, err = providerCredentials{BaseURL: "https://x.api.openvpn.com", CloudID: "acme"}.baseURL()
+	require.NoError(t, err)
+	assert.Equal(t, "https://x.api.openvpn.com", u)
+
+	_, err = providerCredentials{CloudID: "acme.evil.com/"}.baseURL()
+	assert.Error(t, err)
+}
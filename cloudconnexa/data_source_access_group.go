@@ -55,7 +55,7 @@ func dataSourceAccessGroup() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceAccessGroupRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var group *cloudconnexa.AccessGroup
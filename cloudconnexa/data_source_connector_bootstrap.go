@@ -0,0 +1,134 @@
+package cloudconnexa
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dnsLabel matches the names accepted by `name` and `namespace`.
+var dnsLabel = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// dataSourceConnectorBootstrap returns a Terraform data source that renders
+// the artifacts needed to run a network or host connector.
+func dataSourceConnectorBootstrap() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use a `cloudconnexa_connector_bootstrap` data source to render ready-to-use artifacts that run a network or host connector: " +
+			"cloud-init user data, a systemd unit with its environment file, a docker-compose service, or a Kubernetes Secret and Deployment. " +
+			"The connector runs the `openvpn` client with the connector profile.",
+		ReadContext: dataSourceConnectorBootstrapRead,
+		Schema: map[string]*schema.Schema{
+			"network_connector_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"network_connector_id", "host_connector_id"},
+				Description:  "The ID of the network connector to bootstrap.",
+			},
+			"host_connector_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the host connector to bootstrap.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(bootstrapFormats, false),
+				Description:  "The artifact format. Valid values are `cloud-init`, `systemd`, `docker-compose` and `kubernetes`.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(dnsLabel, "must be a lowercase DNS label"),
+				Description:  "The name of the systemd unit, compose service or Kubernetes objects. Defaults to `cloudconnexa-` followed by the connector name.",
+			},
+			"image": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The container image for the `docker-compose` and `kubernetes` formats. It must provide the `openvpn` and `sysctl` commands.",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ValidateFunc: validation.StringMatch(dnsLabel, "must be a lowercase DNS label"),
+				Description:  "The Kubernetes namespace for the `kubernetes` format. Defaults to `default`.",
+			},
+			"template_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the templates the artifacts were rendered from. It changes when a provider upgrade alters the rendered artifacts.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The main document: the cloud-init user data, the systemd unit, the docker-compose file or the Kubernetes manifest.",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Every file to deploy by path, including `content`. Paths are absolute for `systemd` and relative to the project or manifest directory otherwise.",
+			},
+		},
+	}
+}
+
+// dataSourceConnectorBootstrapRead looks up the connector with its profile
+// and renders the requested format.
+//
+// Parameters:
+//   - ctx: The context for the operation
+//   - d: The Terraform resource data
+//   - m: The provider meta interface
+//
+// Returns:
+//   - diag.Diagnostics: Diagnostics containing any errors that occurred
+func dataSourceConnectorBootstrapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := clientFromMeta(ctx, m)
+	in := bootstrapInput{
+		Image:     d.Get("image").(string),
+		Namespace: d.Get("namespace").(string),
+	}
+	if id := d.Get("network_connector_id").(string); id != "" {
+		connector, err := c.NetworkConnectors.GetByID(id)
+		if err != nil {
+			return diag.Errorf("Failed to get network connector with ID: %s, %s", id, err)
+		}
+		in.ConnectorID, in.ConnectorName, in.VpnRegionID = connector.ID, connector.Name, connector.VpnRegionID
+		if in.Profile, err = c.NetworkConnectors.GetProfile(id); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		id := d.Get("host_connector_id").(string)
+		connector, err := c.HostConnectors.GetByID(id)
+		if err != nil {
+			return diag.Errorf("Failed to get host connector with ID: %s, %s", id, err)
+		}
+		in.ConnectorID, in.ConnectorName, in.VpnRegionID = connector.ID, connector.Name, connector.VpnRegionID
+		if in.Profile, err = c.HostConnectors.GetProfile(id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	in.Name = d.Get("name").(string)
+	if in.Name == "" {
+		in.Name = bootstrapName(in.ConnectorName)
+	}
+
+	format := d.Get("format").(string)
+	artifact, err := renderBootstrap(format, in)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(in.ConnectorID + "/" + format)
+	d.Set("name", in.Name)
+	d.Set("template_version", bootstrapTemplateVersion)
+	d.Set("content", artifact.Content)
+	d.Set("files", artifact.Files)
+	return nil
+}
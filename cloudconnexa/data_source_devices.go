@@ -66,7 +66,7 @@ func dataSourceDevices() *schema.Resource {
 
 // dataSourceDevicesRead handles the read operation for the devices data source.
 func dataSourceDevicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	var devices []cloudconnexa.DeviceDetail
@@ -151,7 +151,7 @@ func dataSourceDevice() *schema.Resource {
 
 // dataSourceDeviceRead handles the read operation for a single device data source.
 func dataSourceDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	deviceID := d.Get("device_id").(string)
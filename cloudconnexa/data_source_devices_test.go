@@ -9,8 +9,8 @@ import (
 // TestAccCloudConnexaDataSourceDevices_basic tests the basic functionality of the devices data source.
 func TestAccCloudConnexaDataSourceDevices_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaDataSourceDevicesConfig(),
@@ -74,7 +74,7 @@ func dataSourceHost() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceHostRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var host *cloudconnexa.Host
@@ -59,7 +59,7 @@ func dataSourceHostApplication() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceHostApplicationRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var application *cloudconnexa.ApplicationResponse
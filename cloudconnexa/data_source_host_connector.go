@@ -79,7 +79,7 @@ func dataSourceHostConnector() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceHostConnectorRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var connector *cloudconnexa.HostConnector
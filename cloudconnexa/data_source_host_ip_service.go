@@ -63,7 +63,7 @@ func dataSourceHostIPService() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceHostIPServiceRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var service *cloudconnexa.HostIPServiceResponse
@@ -65,7 +65,7 @@ func dataSourceLocationContext() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceLocationContextRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var context *cloudconnexa.LocationContext
@@ -82,7 +82,7 @@ func dataSourceNetwork() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceNetworkRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var network *cloudconnexa.Network
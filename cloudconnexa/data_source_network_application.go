@@ -57,7 +57,7 @@ func dataSourceNetworkApplication() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors or warnings
 func dataSourceNetworkApplicationRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var application *cloudconnexa.NetworkApplicationResponse
@@ -84,7 +84,7 @@ func dataSourceNetworkConnector() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceNetworkConnectorRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var connector *cloudconnexa.NetworkConnector
@@ -126,7 +126,7 @@ func dataSourceSessions() *schema.Resource {
 
 // dataSourceSessionsRead handles the read operation for the sessions data source.
 func dataSourceSessionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	options := cloudconnexa.SessionsListOptions{
@@ -9,8 +9,8 @@ import (
 // TestAccCloudConnexaDataSourceSessions_basic tests the basic functionality of the sessions data source.
 func TestAccCloudConnexaDataSourceSessions_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaDataSourceSessionsConfig(),
@@ -25,8 +25,8 @@ func TestAccCloudConnexaDataSourceSessions_basic(t *testing.T) {
 // TestAccCloudConnexaDataSourceSessions_withStatus tests the sessions data source with status filter.
 func TestAccCloudConnexaDataSourceSessions_withStatus(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaDataSourceSessionsConfigWithStatus("ACTIVE"),
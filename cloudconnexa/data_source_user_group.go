@@ -107,7 +107,7 @@ func dataSourceUserGroup() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceUserGroupRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	var id = data.Get("id").(string)
 	var userGroup *cloudconnexa.UserGroup
@@ -3,8 +3,6 @@ package cloudconnexa
 import (
 	"context"
 
-	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -60,7 +58,7 @@ func dataSourceVpnRegion() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
 func dataSourceVpnRegionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Get("id").(string)
 
@@ -0,0 +1,127 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// ephemeralResources returns constructors for the provider's ephemeral
+// resources by type name.
+func ephemeralResources() map[string]func() ephemeral.EphemeralResource {
+	return map[string]func() ephemeral.EphemeralResource{
+		"cloudconnexa_host_connector_credentials": func() ephemeral.EphemeralResource {
+			return &connectorCredentials{
+				kind: "host",
+				fetch: func(c *cloudconnexa.Client, id string) (string, string, error) {
+					token, err := c.HostConnectors.GetToken(id)
+					if err != nil {
+						return "", "", err
+					}
+					profile, err := c.HostConnectors.GetProfile(id)
+					return token, profile, err
+				},
+			}
+		},
+		"cloudconnexa_network_connector_credentials": func() ephemeral.EphemeralResource {
+			return &connectorCredentials{
+				kind: "network",
+				fetch: func(c *cloudconnexa.Client, id string) (string, string, error) {
+					token, err := c.NetworkConnectors.GetToken(id)
+					if err != nil {
+						return "", "", err
+					}
+					profile, err := c.NetworkConnectors.GetProfile(id)
+					return token, profile, err
+				},
+			}
+		},
+	}
+}
+
+// connectorCredentials is an ephemeral resource that reads the token and
+// OpenVPN profile of a host or network connector. Ephemeral resource
+// results are never persisted in plan or state.
+type connectorCredentials struct {
+	kind   string
+	fetch  func(c *cloudconnexa.Client, id string) (token, profile string, err error)
+	client *cloudconnexa.Client
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = (*connectorCredentials)(nil)
+
+// connectorCredentialsModel is the data of connectorCredentials.
+type connectorCredentialsModel struct {
+	ID      types.String `tfsdk:"id"`
+	Profile types.String `tfsdk:"profile"`
+	Token   types.String `tfsdk:"token"`
+}
+
+// Metadata implements ephemeral.EphemeralResource.
+func (r *connectorCredentials) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.kind + "_connector_credentials"
+}
+
+// Schema implements ephemeral.EphemeralResource.
+func (r *connectorCredentials) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Use `cloudconnexa_%s_connector_credentials` to obtain the token and OpenVPN profile of a %s connector without storing them in plan or state.", r.kind, r.kind),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The ID of the %s connector.", r.kind),
+			},
+			"profile": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "OpenVPN profile of the connector.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Connector token.",
+			},
+		},
+	}
+}
+
+// Configure implements ephemeral.EphemeralResourceWithConfigure.
+func (r *connectorCredentials) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if c := providerDataClient(req.ProviderData, &resp.Diagnostics); c != nil {
+		r.client = c
+	}
+}
+
+// Open implements ephemeral.EphemeralResource. An ID that is not known yet
+// yields an unknown result.
+func (r *connectorCredentials) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data connectorCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.ID.IsUnknown() {
+		data.Profile = types.StringUnknown()
+		data.Token = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unable to open cloudconnexa_"+r.kind+"_connector_credentials", errProviderNotConfigured.Error())
+		return
+	}
+	id := data.ID.ValueString()
+	token, profile, err := r.fetch(clientFromMeta(ctx, r.client), id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to open cloudconnexa_"+r.kind+"_connector_credentials",
+			fmt.Sprintf("failed to get credentials of %s connector %s: %s", r.kind, id, err))
+		return
+	}
+	data.Profile = types.StringValue(profile)
+	data.Token = types.StringValue(token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,150 @@
+package cloudconnexa
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// connectorCredentialsHandler answers connector profile and token requests
+// and counts them.
+func connectorCredentialsHandler(credentialCalls *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/profile/encrypt"):
+			credentialCalls.Add(1)
+			_, _ = w.Write([]byte("connector-token"))
+		case strings.HasSuffix(r.URL.Path, "/profile"):
+			credentialCalls.Add(1)
+			_, _ = w.Write([]byte("connector-profile"))
+		default:
+			_, _ = w.Write([]byte(`{"id":"connector-id","name":"c","networkItemId":"host-id","tunnelingProtocol":"OPENVPN"}`))
+		}
+	})
+}
+
+// newConnectorCredentialsClient returns a client for
+// connectorCredentialsHandler without the go-client's rate limits.
+func newConnectorCredentialsClient(t *testing.T, credentialCalls *atomic.Int32) *cloudconnexa.Client {
+	t.Helper()
+	c := newHostUnitTestClient(t, connectorCredentialsHandler(credentialCalls))
+	c.ReadRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	c.UpdateRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	return c
+}
+
+// openTestCredentials opens the ephemeral resource typeName through server
+// for the connector id and returns the result attributes and diagnostics.
+func openTestCredentials(t *testing.T, server tfprotov5.ProviderServer, typeName string, id tftypes.Value) (map[string]tftypes.Value, []*tfprotov5.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Contains(t, schemas.EphemeralResourceSchemas, typeName)
+	typ := schemas.EphemeralResourceSchemas[typeName].ValueType()
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id":      id,
+		"profile": tftypes.NewValue(tftypes.String, nil),
+		"token":   tftypes.NewValue(tftypes.String, nil),
+	}))
+	require.NoError(t, err)
+
+	validate, err := server.ValidateEphemeralResourceConfig(ctx, &tfprotov5.ValidateEphemeralResourceConfigRequest{TypeName: typeName, Config: &config})
+	require.NoError(t, err)
+	require.Empty(t, validate.Diagnostics, typeName)
+
+	resp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{TypeName: typeName, Config: &config})
+	require.NoError(t, err)
+	if resp.Result == nil {
+		return nil, resp.Diagnostics
+	}
+	result, err := resp.Result.Unmarshal(typ)
+	require.NoError(t, err)
+	attrs := map[string]tftypes.Value{}
+	require.NoError(t, result.As(&attrs))
+	return attrs, resp.Diagnostics
+}
+
+// TestUnitEphemeralConnectorCredentials_Open checks that both ephemeral
+// resources return the connector token and profile.
+func TestUnitEphemeralConnectorCredentials_Open(t *testing.T) {
+	var credentialCalls atomic.Int32
+	p := Provider()
+	p.SetMeta(newConnectorCredentialsClient(t, &credentialCalls))
+	server := newFrameworkTestServer(t, p)
+
+	for _, typeName := range []string{"cloudconnexa_host_connector_credentials", "cloudconnexa_network_connector_credentials"} {
+		result, diags := openTestCredentials(t, server, typeName, stringValue("connector-id"))
+		require.Empty(t, diags, typeName)
+		assert.True(t, result["token"].Equal(stringValue("connector-token")), typeName)
+		assert.True(t, result["profile"].Equal(stringValue("connector-profile")), typeName)
+	}
+	assert.EqualValues(t, 4, credentialCalls.Load())
+}
+
+// TestUnitEphemeralConnectorCredentials_UnknownID checks that an ID that is
+// not known yet yields an unknown result without calling the API.
+func TestUnitEphemeralConnectorCredentials_UnknownID(t *testing.T) {
+	var credentialCalls atomic.Int32
+	p := Provider()
+	p.SetMeta(newConnectorCredentialsClient(t, &credentialCalls))
+	server := newFrameworkTestServer(t, p)
+
+	result, diags := openTestCredentials(t, server, "cloudconnexa_host_connector_credentials", tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+	require.Empty(t, diags)
+	assert.False(t, result["token"].IsKnown())
+	assert.Zero(t, credentialCalls.Load())
+}
+
+// TestUnitEphemeralConnectorCredentials_Unconfigured checks that opening an
+// ephemeral resource before the provider is configured fails with a
+// diagnostic.
+func TestUnitEphemeralConnectorCredentials_Unconfigured(t *testing.T) {
+	server := newFrameworkTestServer(t, Provider())
+	_, diags := openTestCredentials(t, server, "cloudconnexa_network_connector_credentials", stringValue("connector-id"))
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail, "not been configured")
+
+	resp, err := server.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{TypeName: "cloudconnexa_unknown"})
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, "Ephemeral Resource Type Not Found", resp.Diagnostics[0].Summary)
+}
+
+// TestUnitConnectorOmitCredentials checks that `omit_credentials` keeps the
+// connector token and profile out of state without fetching them.
+func TestUnitConnectorOmitCredentials(t *testing.T) {
+	for name, read := range map[string]schema.ReadContextFunc{
+		"cloudconnexa_host_connector":    resourceHostConnectorRead,
+		"cloudconnexa_network_connector": resourceNetworkConnectorRead,
+	} {
+		var credentialCalls atomic.Int32
+		c := newConnectorCredentialsClient(t, &credentialCalls)
+		r := Provider().ResourcesMap[name]
+
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+		d.SetId("connector-id")
+		diags := read(context.Background(), d, c)
+		require.False(t, diags.HasError(), "%s: %v", name, diags)
+		assert.Equal(t, "connector-token", d.Get("token"), name)
+		assert.EqualValues(t, 2, credentialCalls.Load(), name)
+
+		d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"omit_credentials": true})
+		d.SetId("connector-id")
+		diags = read(context.Background(), d, c)
+		require.False(t, diags.HasError(), "%s: %v", name, diags)
+		assert.Empty(t, d.Get("token"), name)
+		assert.Empty(t, d.Get("profile"), name)
+		assert.EqualValues(t, 2, credentialCalls.Load(), name)
+	}
+}
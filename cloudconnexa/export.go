@@ -0,0 +1,471 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	zcty "github.com/zclconf/go-cty/cty"
+)
+
+// exportIgnoredAttributes are not written to exported configuration: list
+// resources set them only to avoid fetching secrets while reading.
+var exportIgnoredAttributes = map[string]bool{
+	"omit_credentials": true,
+}
+
+// exportExcludedTypes are the resource types that Export does not write,
+// with the reason. Every other resource type has a list resource.
+var exportExcludedTypes = map[string]string{
+	"cloudconnexa_device": "devices are registered by the VPN clients of their users, and importing one needs the ID of its user " +
+		"(`<user_id>/<device_id>`); import the devices to manage with `terraform import`",
+}
+
+// exportedObject is an object read for export.
+type exportedObject struct {
+	resourceType string
+	name         string
+	id           string
+	value        cty.Value
+}
+
+// Export writes Terraform configuration for every object the provider
+// manages in the account to dir: one `<type>.tf` file per resource type and
+// an `imports.tf` file with an `import` block per object. The provider is
+// configured from providerConfig and the environment, as Terraform would
+// with a provider block of these arguments, and always in read-only mode. IDs of other exported objects, such
+// as `host_id` or `group_id`, are written as references to those objects.
+// Sensitive arguments are not exported: those that are required or set on
+// the object refer to a sensitive variable declared in `variables.tf`. A
+// warning is written to warnings for each resource type that is not
+// exported.
+//
+// Parameters:
+//   - ctx: The context for the operation
+//   - dir: The directory to write the files to; it is created if needed
+//   - providerConfig: Provider arguments, e.g. `cloud_id`; may be nil
+//   - warnings: The writer for warnings, e.g. os.Stderr
+//
+// Returns:
+//   - error: An error if the account could not be read or a file not written
+func Export(ctx context.Context, dir string, providerConfig map[string]interface{}, warnings io.Writer) error {
+	p, err := readOnlyProvider(ctx, providerConfig)
+	if err != nil {
+		return err
+	}
+	writeExcludedTypes(warnings, "not exported", exportExcludedTypes)
+	objects, err := exportObjects(ctx, p, slices.Sorted(maps.Keys(listResources())))
+	if err != nil {
+		return err
+	}
+	files := exportFiles(p, objects)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeExcludedTypes writes a warning for each of the excluded resource
+// types, e.g. that it is "not exported", with the reason.
+func writeExcludedTypes(w io.Writer, what string, excluded map[string]string) {
+	for _, resourceType := range slices.Sorted(maps.Keys(excluded)) {
+		fmt.Fprintf(w, "Warning: %s is %s: %s.\n", resourceType, what, excluded[resourceType])
+	}
+}
+
+// readOnlyProvider returns the provider configured from providerConfig and
+// the environment in read-only mode, for the subcommands of the provider
+// binary.
+func readOnlyProvider(ctx context.Context, providerConfig map[string]interface{}) (*schema.Provider, error) {
+	raw := map[string]interface{}{}
+	maps.Copy(raw, providerConfig)
+	raw["read_only"] = true
+	p := Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		return nil, fmt.Errorf("configuring the provider: %s: %s", diags[0].Summary, diags[0].Detail)
+	}
+	return p, nil
+}
+
+// exportObjects lists and reads all objects of the given resource types
+// through their list resources. Objects get unique resource names derived
+// from their display names.
+func exportObjects(ctx context.Context, p *schema.Provider, resourceTypes []string) ([]*exportedObject, error) {
+	c := clientFromMeta(ctx, p.Meta())
+	lists := listResources()
+	var objects []*exportedObject
+	for _, resourceType := range resourceTypes {
+		l := lists[resourceType]
+		if l == nil || exportSchemaOf(p, resourceType) == nil {
+			return nil, fmt.Errorf("resource type %s cannot be exported", resourceType)
+		}
+		items, err := l.list(ctx, c, "")
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", resourceType, err)
+		}
+		slices.SortStableFunc(items, func(a, b listItem) int { return strings.Compare(a.displayName, b.displayName) })
+		names := map[string]bool{}
+		for _, item := range items {
+			value, err := l.read(ctx, p, resourceType, item.id)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s %s: %w", resourceType, item.id, err)
+			}
+			if value.IsNull() {
+				continue
+			}
+			objects = append(objects, &exportedObject{
+				resourceType: resourceType,
+				name:         uniqueResourceName(resourceType, item.displayName, names),
+				id:           item.id,
+				value:        value,
+			})
+		}
+	}
+	return objects, nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueResourceName turns displayName into a Terraform resource name that
+// is not in names yet, and adds it to names.
+func uniqueResourceName(resourceType, displayName string, names map[string]bool) string {
+	base := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = strings.TrimPrefix(resourceType, "cloudconnexa_") + "_" + base
+		base = strings.TrimSuffix(base, "_")
+	}
+	name := base
+	for i := 2; names[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	names[name] = true
+	return name
+}
+
+// exportFiles renders objects as configuration files by file name.
+func exportFiles(p *schema.Provider, objects []*exportedObject) map[string][]byte {
+	refs := map[string]hcl.Traversal{}
+	for _, o := range objects {
+		refs[o.id] = hcl.Traversal{
+			hcl.TraverseRoot{Name: o.resourceType},
+			hcl.TraverseAttr{Name: o.name},
+			hcl.TraverseAttr{Name: "id"},
+		}
+	}
+
+	typeFiles := map[string]*hclwrite.File{}
+	imports := hclwrite.NewEmptyFile()
+	variables := hclwrite.NewEmptyFile()
+	for _, o := range objects {
+		f, ok := typeFiles[o.resourceType]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			typeFiles[o.resourceType] = f
+		} else {
+			f.Body().AppendNewline()
+		}
+		block := f.Body().AppendNewBlock("resource", []string{o.resourceType, o.name})
+		w := &exportWriter{selfID: o.id, refs: refs}
+		w.writeBody(block.Body(), exportSchemaOf(p, o.resourceType), o.value, []string{strings.TrimPrefix(o.resourceType, "cloudconnexa_") + "_" + o.name})
+		for _, v := range w.variables {
+			if len(variables.Body().Blocks()) > 0 {
+				variables.Body().AppendNewline()
+			}
+			variableBlock := variables.Body().AppendNewBlock("variable", []string{v.name})
+			variableBlock.Body().SetAttributeValue("description", zcty.StringVal(
+				fmt.Sprintf("The %s of %s.%s, which is sensitive and not exported.", v.argument, o.resourceType, o.name)))
+			variableBlock.Body().SetAttributeRaw("type", hclwrite.TokensForIdentifier(v.typeName))
+			variableBlock.Body().SetAttributeValue("sensitive", zcty.True)
+		}
+
+		if len(imports.Body().Blocks()) > 0 {
+			imports.Body().AppendNewline()
+		}
+		importBlock := imports.Body().AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: o.resourceType},
+			hcl.TraverseAttr{Name: o.name},
+		})
+		importBlock.Body().SetAttributeValue("id", zcty.StringVal(o.id))
+	}
+
+	files := map[string][]byte{"imports.tf": imports.Bytes()}
+	if len(variables.Body().Blocks()) > 0 {
+		files["variables.tf"] = variables.Bytes()
+	}
+	for resourceType, f := range typeFiles {
+		files[strings.TrimPrefix(resourceType, "cloudconnexa_")+".tf"] = f.Bytes()
+	}
+	return files
+}
+
+// exportArgument describes an argument of a resource type, or of one of its
+// blocks, for export, whichever SDK the resource is implemented with.
+type exportArgument struct {
+	required, optional    bool
+	deprecated, sensitive bool
+	writeOnly             bool
+	// block holds the arguments of a nested block, nil for attributes.
+	// single is set for a block that is an object rather than a list or set
+	// of objects.
+	block  map[string]*exportArgument
+	single bool
+	// defaultValue is the value of an unset argument, cty.NilVal if none.
+	defaultValue cty.Value
+}
+
+// exportSchemaOf returns the arguments of resourceType, an SDKv2 resource of
+// p or a framework resource, or nil if there is no such resource type.
+func exportSchemaOf(p *schema.Provider, resourceType string) map[string]*exportArgument {
+	if newResource, ok := frameworkResources()[resourceType]; ok {
+		var resp resource.SchemaResponse
+		newResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+		return frameworkExportArguments(resp.Schema.Attributes, resp.Schema.Blocks)
+	}
+	if r, ok := p.ResourcesMap[resourceType]; ok {
+		return sdkExportArguments(r.SchemaMap())
+	}
+	return nil
+}
+
+// sdkExportArguments returns the export arguments of the SDKv2 schema s.
+func sdkExportArguments(s map[string]*schema.Schema) map[string]*exportArgument {
+	args := map[string]*exportArgument{}
+	for name, as := range s {
+		arg := &exportArgument{
+			required:   as.Required,
+			optional:   as.Optional,
+			deprecated: as.Deprecated != "",
+			sensitive:  as.Sensitive,
+			writeOnly:  as.WriteOnly,
+		}
+		if elem, ok := as.Elem.(*schema.Resource); ok && as.ConfigMode != schema.SchemaConfigModeAttr {
+			arg.block = sdkExportArguments(elem.SchemaMap())
+		}
+		switch d := as.Default.(type) {
+		case string:
+			arg.defaultValue = cty.StringVal(d)
+		case bool:
+			arg.defaultValue = cty.BoolVal(d)
+		case int:
+			arg.defaultValue = cty.NumberIntVal(int64(d))
+		case nil:
+			// SDKv2 stores unset numbers as zero, which may not be a valid
+			// value, e.g. for `pre_shared_key_wo_version`.
+			if !as.Computed && (as.Type == schema.TypeInt || as.Type == schema.TypeFloat) {
+				arg.defaultValue = cty.NumberIntVal(0)
+			}
+		}
+		args[name] = arg
+	}
+	return args
+}
+
+// frameworkExportArguments returns the export arguments of the framework
+// schema attributes and blocks. Only static string, bool and number
+// defaults are known.
+func frameworkExportArguments(attributes map[string]resourceschema.Attribute, blocks map[string]resourceschema.Block) map[string]*exportArgument {
+	ctx := context.Background()
+	args := map[string]*exportArgument{}
+	for name, a := range attributes {
+		arg := &exportArgument{
+			required:   a.IsRequired(),
+			optional:   a.IsOptional(),
+			deprecated: a.GetDeprecationMessage() != "",
+			sensitive:  a.IsSensitive(),
+			writeOnly:  a.IsWriteOnly(),
+		}
+		switch a := a.(type) {
+		case resourceschema.StringAttribute:
+			if a.Default != nil {
+				var resp defaults.StringResponse
+				a.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+				arg.defaultValue = cty.StringVal(resp.PlanValue.ValueString())
+			}
+		case resourceschema.BoolAttribute:
+			if a.Default != nil {
+				var resp defaults.BoolResponse
+				a.Default.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+				arg.defaultValue = cty.BoolVal(resp.PlanValue.ValueBool())
+			}
+		case resourceschema.Int64Attribute:
+			if a.Default != nil {
+				var resp defaults.Int64Response
+				a.Default.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
+				arg.defaultValue = cty.NumberIntVal(resp.PlanValue.ValueInt64())
+			}
+		}
+		args[name] = arg
+	}
+	for name, b := range blocks {
+		arg := &exportArgument{optional: true}
+		switch b := b.(type) {
+		case resourceschema.ListNestedBlock:
+			arg.block = frameworkExportArguments(b.NestedObject.Attributes, b.NestedObject.Blocks)
+		case resourceschema.SetNestedBlock:
+			arg.block = frameworkExportArguments(b.NestedObject.Attributes, b.NestedObject.Blocks)
+		case resourceschema.SingleNestedBlock:
+			arg.block, arg.single = frameworkExportArguments(b.Attributes, b.Blocks), true
+		default:
+			continue
+		}
+		args[name] = arg
+	}
+	return args
+}
+
+// exportWriter writes the configuration of an exported object.
+type exportWriter struct {
+	// selfID is the ID of the object, which is not written as a reference.
+	selfID string
+	// refs are the references to exported objects by ID.
+	refs map[string]hcl.Traversal
+	// variables are the variables the object's sensitive arguments refer
+	// to.
+	variables []exportVariable
+}
+
+// exportVariable is a variable standing in for a sensitive argument.
+type exportVariable struct {
+	// name is the variable name, e.g.
+	// `network_connector_aws_ipsec_config_pre_shared_key`.
+	name string
+	// argument is the path of the argument, e.g. `ipsec_config.pre_shared_key`.
+	argument string
+	// typeName is the variable type, e.g. `string`.
+	typeName string
+}
+
+// writeBody writes the arguments of val, an object with the arguments args,
+// to body. Computed-only, deprecated and write-only attributes are skipped,
+// as are optional arguments that are empty or at their default. Sensitive
+// arguments that are required or set refer to a new variable instead of
+// holding their value, and are skipped otherwise. Strings that are IDs of
+// exported objects other than w.selfID are written as references. path
+// starts with the object's variable name prefix, e.g.
+// `network_connector_aws`, followed by the path of val in the object, with
+// list indexes only for lists of several blocks.
+func (w *exportWriter) writeBody(body *hclwrite.Body, args map[string]*exportArgument, val cty.Value, path []string) {
+	for _, name := range slices.Sorted(maps.Keys(args)) {
+		arg := args[name]
+		if (!arg.required && !arg.optional) || arg.deprecated || arg.writeOnly || exportIgnoredAttributes[name] {
+			continue
+		}
+		v := val.GetAttr(name)
+		if arg.sensitive && arg.block == nil {
+			if arg.required || (v.IsKnown() && !v.IsNull() && !isDefaultExportValue(arg, v)) {
+				body.SetAttributeTraversal(name, w.variable(slices.Concat(path, []string{name}), v.Type()))
+			}
+			continue
+		}
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		if arg.block != nil {
+			if arg.single {
+				w.writeBody(body.AppendNewBlock(name, nil).Body(), arg.block, v, slices.Concat(path, []string{name}))
+				continue
+			}
+			for it := v.ElementIterator(); it.Next(); {
+				k, ev := it.Element()
+				elemPath := slices.Concat(path, []string{name})
+				if k.Type() == cty.Number && v.LengthInt() > 1 {
+					elemPath = append(elemPath, k.AsBigFloat().String())
+				}
+				w.writeBody(body.AppendNewBlock(name, nil).Body(), arg.block, ev, elemPath)
+			}
+			continue
+		}
+		if !arg.required && isDefaultExportValue(arg, v) {
+			continue
+		}
+		body.SetAttributeRaw(name, exportValueTokens(v, w.selfID, w.refs))
+	}
+}
+
+// variable adds the variable for the sensitive argument at path, of type
+// ty, and returns the reference to it.
+func (w *exportWriter) variable(path []string, ty cty.Type) hcl.Traversal {
+	typeName := "any"
+	switch ty {
+	case cty.String:
+		typeName = "string"
+	case cty.Number:
+		typeName = "number"
+	case cty.Bool:
+		typeName = "bool"
+	}
+	v := exportVariable{
+		name:     strings.Join(path, "_"),
+		argument: strings.Join(path[1:], "."),
+		typeName: typeName,
+	}
+	w.variables = append(w.variables, v)
+	return hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: v.name}}
+}
+
+// isDefaultExportValue reports whether v is empty or the default of arg.
+func isDefaultExportValue(arg *exportArgument, v cty.Value) bool {
+	switch {
+	case v.Type() == cty.String && v.AsString() == "":
+		return true
+	case (v.Type().IsListType() || v.Type().IsSetType() || v.Type().IsMapType()) && v.LengthInt() == 0:
+		return true
+	case arg.defaultValue == cty.NilVal || !arg.defaultValue.Type().Equals(v.Type()):
+		return false
+	}
+	return v.Equals(arg.defaultValue).True()
+}
+
+// exportValueTokens returns the HCL tokens for v.
+func exportValueTokens(v cty.Value, selfID string, refs map[string]hcl.Traversal) hclwrite.Tokens {
+	ty := v.Type()
+	switch {
+	case ty == cty.String:
+		if ref, ok := refs[v.AsString()]; ok && v.AsString() != selfID {
+			return hclwrite.TokensForTraversal(ref)
+		}
+		return hclwrite.TokensForValue(zcty.StringVal(v.AsString()))
+	case ty == cty.Bool:
+		return hclwrite.TokensForValue(zcty.BoolVal(v.True()))
+	case ty == cty.Number:
+		return hclwrite.TokensForValue(zcty.NumberVal(v.AsBigFloat()))
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		var elems []hclwrite.Tokens
+		for it := v.ElementIterator(); it.Next(); {
+			_, ev := it.Element()
+			elems = append(elems, exportValueTokens(ev, selfID, refs))
+		}
+		return hclwrite.TokensForTuple(elems)
+	case ty.IsMapType() || ty.IsObjectType():
+		var attrs []hclwrite.ObjectAttrTokens
+		for it := v.ElementIterator(); it.Next(); {
+			k, ev := it.Element()
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(zcty.StringVal(k.AsString())),
+				Value: exportValueTokens(ev, selfID, refs),
+			})
+		}
+		return hclwrite.TokensForObject(attrs)
+	}
+	return hclwrite.TokensForIdentifier("null")
+}
@@ -0,0 +1,219 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// exportTestHandler serves a host and a connector of that host.
+func exportTestHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/hosts":
+			_, _ = w.Write([]byte(`{"content":[{"id":"host-1","name":"Web Servers"}],"totalPages":1}`))
+		case "/api/v1/hosts/host-1":
+			_, _ = w.Write([]byte(`{"id":"host-1","name":"Web Servers","description":"Managed by Terraform","internetAccess":"SPLIT_TUNNEL_OFF","systemSubnets":["100.96.0.0/24"]}`))
+		case "/api/v1/hosts/connectors":
+			_, _ = w.Write([]byte(`{"content":[{"id":"connector-1","name":"web-1","networkItemId":"host-1"}],"totalPages":1}`))
+		case "/api/v1/hosts/connectors/connector-1":
+			_, _ = w.Write([]byte(`{"id":"connector-1","name":"web-1","description":"Frankfurt \"primary\"","networkItemId":"host-1","vpnRegionId":"eu-central-1"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// TestUnitExport checks the generated configuration and import blocks,
+// including the reference from the connector to its host.
+func TestUnitExport(t *testing.T) {
+	c := newHostUnitTestClient(t, exportTestHandler())
+	c.ReadRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	c.UpdateRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	p := Provider()
+	p.SetMeta(c)
+
+	objects, err := exportObjects(context.Background(), p, []string{"cloudconnexa_host", "cloudconnexa_host_connector"})
+	require.NoError(t, err)
+	files := exportFiles(p, objects)
+
+	assert.Equal(t, `resource "cloudconnexa_host" "web_servers" {
+  internet_access = "SPLIT_TUNNEL_OFF"
+  name            = "Web Servers"
+}
+`, string(files["host.tf"]))
+	assert.Equal(t, `resource "cloudconnexa_host_connector" "web_1" {
+  description   = "Frankfurt \"primary\""
+  host_id       = cloudconnexa_host.web_servers.id
+  name          = "web-1"
+  vpn_region_id = "eu-central-1"
+}
+`, string(files["host_connector.tf"]))
+	assert.Equal(t, `import {
+  to = cloudconnexa_host.web_servers
+  id = "host-1"
+}
+
+import {
+  to = cloudconnexa_host_connector.web_1
+  id = "connector-1"
+}
+`, string(files["imports.tf"]))
+	assert.NotContains(t, files, "variables.tf")
+}
+
+// TestUnitExport_FrameworkResource checks that objects of framework
+// resources are read and written like those of SDKv2 resources, without
+// optional arguments at their default or unset.
+func TestUnitExport_FrameworkResource(t *testing.T) {
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/dns-records":
+			_, _ = w.Write([]byte(`{"content":[{"id":"record-1","domain":"app.example.com"},{"id":"record-2","domain":"db.example.com"}],"totalPages":1}`))
+		case "/api/v1/dns-records/record-1":
+			_, _ = w.Write([]byte(`{"id":"record-1","domain":"app.example.com","description":"Managed by Terraform","ipv4Addresses":["10.0.0.10"],"ipv6Addresses":[]}`))
+		case "/api/v1/dns-records/record-2":
+			_, _ = w.Write([]byte(`{"id":"record-2","domain":"db.example.com","description":"Primary database","ipv4Addresses":[],"ipv6Addresses":["fd00::5"]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	c.ReadRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	p := Provider()
+	p.SetMeta(c)
+
+	objects, err := exportObjects(context.Background(), p, []string{"cloudconnexa_dns_record"})
+	require.NoError(t, err)
+	files := exportFiles(p, objects)
+
+	assert.Equal(t, `resource "cloudconnexa_dns_record" "app_example_com" {
+  domain          = "app.example.com"
+  ip_v4_addresses = ["10.0.0.10"]
+}
+
+resource "cloudconnexa_dns_record" "db_example_com" {
+  description     = "Primary database"
+  domain          = "db.example.com"
+  ip_v6_addresses = ["fd00::5"]
+}
+`, string(files["dns_record.tf"]))
+}
+
+// TestUnitExport_SensitiveArguments checks that sensitive arguments that
+// are set refer to sensitive variables instead of holding their value.
+func TestUnitExport_SensitiveArguments(t *testing.T) {
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/networks/connectors":
+			_, _ = w.Write([]byte(`{"content":[{"id":"connector-1","name":"aws","networkItemId":"network-1"}],"totalPages":1}`))
+		case "/api/v1/networks/connectors/connector-1":
+			_, _ = w.Write([]byte(`{"id":"connector-1","name":"aws","description":"Managed by Terraform","networkItemId":"network-1","vpnRegionId":"us-east-1",` +
+				`"tunnelingProtocol":"IPSEC","ipSecConfig":{"platform":"AWS","authenticationType":"SHARED_SECRET","remoteSitePublicIp":"203.0.113.1",` +
+				`"preSharedKey":"s3cret","connectorState":"STARTED"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	c.ReadRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	p := Provider()
+	p.SetMeta(c)
+
+	objects, err := exportObjects(context.Background(), p, []string{"cloudconnexa_network_connector"})
+	require.NoError(t, err)
+	files := exportFiles(p, objects)
+
+	assert.NotContains(t, string(files["network_connector.tf"]), "s3cret")
+	assert.Contains(t, string(files["network_connector.tf"]), `
+    pre_shared_key        = var.network_connector_aws_ipsec_config_pre_shared_key
+`)
+	assert.NotContains(t, string(files["network_connector.tf"]), "_wo_version")
+	assert.Equal(t, `variable "network_connector_aws_ipsec_config_pre_shared_key" {
+  description = "The ipsec_config.pre_shared_key of cloudconnexa_network_connector.aws, which is sensitive and not exported."
+  type        = string
+  sensitive   = true
+}
+`, string(files["variables.tf"]))
+}
+
+// TestUnitExport_Coverage checks that every resource type is either
+// exported through its list resource or excluded with a reason.
+func TestUnitExport_Coverage(t *testing.T) {
+	p := Provider()
+	lists := listResources()
+	resourceTypes := slices.Concat(slices.Collect(maps.Keys(p.ResourcesMap)), slices.Collect(maps.Keys(frameworkResources())))
+	for _, resourceType := range resourceTypes {
+		_, listed := lists[resourceType]
+		_, excluded := exportExcludedTypes[resourceType]
+		assert.True(t, listed != excluded, "%s must either have a list resource or be excluded from export", resourceType)
+	}
+	for resourceType := range exportExcludedTypes {
+		assert.Contains(t, resourceTypes, resourceType)
+	}
+}
+
+// TestUnitExport_Account checks an export of the fake API: location
+// contexts and the account settings are written, and excluded resource
+// types are reported.
+func TestUnitExport_Account(t *testing.T) {
+	_, config := newFakeAPI(t, fakeapi.Options{})
+	p := Provider()
+	require.False(t, p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)).HasError())
+	ctx := context.Background()
+	userGroup := p.ResourcesMap["cloudconnexa_user_group"]
+	gd := schema.TestResourceDataRaw(t, userGroup.Schema, map[string]interface{}{"name": "Staff"})
+	require.False(t, userGroup.CreateContext(ctx, gd, p.Meta()).HasError())
+	locationContext := p.ResourcesMap["cloudconnexa_location_context"]
+	ld := schema.TestResourceDataRaw(t, locationContext.Schema, map[string]interface{}{
+		"name":            "Office",
+		"user_groups_ids": []interface{}{gd.Id()},
+		"default_check":   []interface{}{map[string]interface{}{"allowed": true}},
+	})
+	require.False(t, locationContext.CreateContext(ctx, ld, p.Meta()).HasError())
+
+	dir := t.TempDir()
+	var warnings bytes.Buffer
+	require.NoError(t, Export(ctx, dir, config, &warnings))
+
+	assert.Equal(t, "Warning: cloudconnexa_device is not exported: "+exportExcludedTypes["cloudconnexa_device"]+".\n", warnings.String())
+	locationContexts, err := os.ReadFile(filepath.Join(dir, "location_context.tf"))
+	require.NoError(t, err)
+	assert.Contains(t, string(locationContexts), `resource "cloudconnexa_location_context" "office" {`)
+	assert.Contains(t, string(locationContexts), `user_groups_ids = [cloudconnexa_user_group.staff.id]`)
+	settings, err := os.ReadFile(filepath.Join(dir, "settings.tf"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(settings), `resource "cloudconnexa_settings" "settings" {`), string(settings))
+	imports, err := os.ReadFile(filepath.Join(dir, "imports.tf"))
+	require.NoError(t, err)
+	assert.Contains(t, string(imports), `import {
+  to = cloudconnexa_settings.settings
+  id = "settings"
+}`)
+}
+
+// TestUnitUniqueResourceName checks the resource names derived from display
+// names.
+func TestUnitUniqueResourceName(t *testing.T) {
+	names := map[string]bool{}
+	for _, tc := range []struct{ displayName, want string }{
+		{"Web Servers", "web_servers"},
+		{"web-servers", "web_servers_2"},
+		{"10.0.0.0/24", "route_10_0_0_0_24"},
+		{"", "route"},
+		{"", "route_2"},
+	} {
+		assert.Equal(t, tc.want, uniqueResourceName("cloudconnexa_route", tc.displayName, names), tc.displayName)
+	}
+}
@@ -0,0 +1,113 @@
+package cloudconnexa
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeAPIProvider starts a fake API with opts and returns it with a
+// provider configured against it by fakeAPIProviderConfig.
+func newFakeAPIProvider(t *testing.T, opts fakeapi.Options) (*fakeapi.Server, *schema.Provider) {
+	t.Helper()
+	srv, config := newFakeAPI(t, opts)
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	require.False(t, diags.HasError(), "%v", diags)
+	return srv, p
+}
+
+// newFakeAPI starts a fake API with opts and returns it with the provider
+// arguments for it: its URL, credentials, `ca_cert_file` and short retry
+// backoffs, so injected faults are retried quickly.
+func newFakeAPI(t *testing.T, opts fakeapi.Options) (*fakeapi.Server, map[string]interface{}) {
+	t.Helper()
+	opts.ClientID, opts.ClientSecret = "fake-id", "fake-secret"
+	srv := fakeapi.New(opts)
+	t.Cleanup(srv.Close)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, srv.WriteCACert(caFile))
+	return srv, map[string]interface{}{
+		"base_url":      srv.URL,
+		"client_id":     "fake-id",
+		"client_secret": "fake-secret",
+		"ca_cert_file":  caFile,
+		"retry": []interface{}{map[string]interface{}{
+			"min_backoff": "10ms",
+			"max_backoff": "50ms",
+		}},
+	}
+}
+
+func TestUnitFakeAPIHostLifecycle(t *testing.T) {
+	srv, p := newFakeAPIProvider(t, fakeapi.Options{})
+	ctx := context.Background()
+	host := p.ResourcesMap["cloudconnexa_host"]
+	connector := p.ResourcesMap["cloudconnexa_host_connector"]
+
+	// Rate limiting and overload on create are retried transparently, and so
+	// are server errors on reads.
+	srv.Inject(fakeapi.Fault{Method: http.MethodPost, Path: "hosts", Status: http.StatusTooManyRequests, Times: 1})
+	srv.Inject(fakeapi.Fault{Method: http.MethodPost, Path: "hosts", Status: http.StatusServiceUnavailable, RetryAfter: time.Second, Times: 1})
+	srv.Inject(fakeapi.Fault{Method: http.MethodGet, Path: "hosts", Status: http.StatusBadGateway, Times: 1})
+	hd := schema.TestResourceDataRaw(t, host.Schema, map[string]interface{}{
+		"name":        "web",
+		"description": "first",
+	})
+	require.False(t, host.CreateContext(ctx, hd, p.Meta()).HasError())
+	require.NotEmpty(t, hd.Id())
+	require.False(t, host.ReadContext(ctx, hd, p.Meta()).HasError())
+	assert.Equal(t, 2, hd.Get("system_subnets").(*schema.Set).Len())
+
+	cd := schema.TestResourceDataRaw(t, connector.Schema, map[string]interface{}{
+		"name":          "web-1",
+		"vpn_region_id": "us-east-1",
+		"host_id":       hd.Id(),
+	})
+	require.False(t, connector.CreateContext(ctx, cd, p.Meta()).HasError())
+	require.False(t, connector.ReadContext(ctx, cd, p.Meta()).HasError())
+	assert.Contains(t, cd.Get("profile"), "remote us-east-1.fake.openvpn.com")
+	assert.Equal(t, "fake-connector-token-"+cd.Id(), cd.Get("token"))
+	assert.Equal(t, "offline", cd.Get("connection_status"))
+
+	ud := schema.TestResourceDataRaw(t, host.Schema, map[string]interface{}{
+		"name":        "web",
+		"description": "second",
+	})
+	ud.SetId(hd.Id())
+	require.False(t, host.UpdateContext(ctx, ud, p.Meta()).HasError())
+	assert.Equal(t, "second", ud.Get("description"))
+
+	require.False(t, host.DeleteContext(ctx, ud, p.Meta()).HasError())
+	c := clientFromMeta(ctx, p.Meta())
+	_, err := c.Hosts.Get(hd.Id())
+	assert.Error(t, err)
+}
+
+func TestUnitFakeAPISettings(t *testing.T) {
+	srv, p := newFakeAPIProvider(t, fakeapi.Options{})
+	ctx := context.Background()
+	settings := p.ResourcesMap["cloudconnexa_settings"]
+
+	d := schema.TestResourceDataRaw(t, settings.Schema, map[string]interface{}{
+		"topology":           "CUSTOM",
+		"connection_timeout": 60,
+		"dns_log_enabled":    true,
+	})
+	require.False(t, settings.CreateContext(ctx, d, p.Meta()).HasError())
+	assert.Equal(t, "CUSTOM", srv.Setting("settings/wpc/topology"))
+	assert.Equal(t, "60", srv.Setting("settings/users/connection-timeout"))
+	assert.Equal(t, "true", srv.Setting("dns-log/user-dns-resolutions/enabled"))
+
+	require.False(t, settings.ReadContext(ctx, d, p.Meta()).HasError())
+	assert.Equal(t, "NO_AUTH", d.Get("connect_auth"))
+	assert.Equal(t, "us-east-1", d.Get("default_region"))
+}
@@ -0,0 +1,199 @@
+package cloudconnexa
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderServerFactory returns a factory for the provider's protocol
+// version 6 server, suitable for tf6server.Serve and acceptance test
+// ProtoV6ProviderFactories. The server muxes the SDKv2 provider and the
+// framework provider at protocol version 5 and upgrades the result.
+//
+// Returns:
+//   - func() (tfprotov6.ProviderServer, error): A function that creates a new server for Provider()
+func ProviderServerFactory() func() (tfprotov6.ProviderServer, error) {
+	return func() (tfprotov6.ProviderServer, error) {
+		return newMuxServer(Provider())
+	}
+}
+
+// newMuxServer returns the protocol version 6 server for the SDKv2
+// provider p and the framework provider sharing its configuration. p is
+// listed first, so it is configured before the framework provider.
+func newMuxServer(p *schema.Provider) (tfprotov6.ProviderServer, error) {
+	ctx := context.Background()
+	mux, err := tf5muxserver.NewMuxServer(ctx,
+		p.GRPCProvider,
+		providerserver.NewProtocol5(&frameworkProvider{sdk: p}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tf5to6server.UpgradeServer(ctx, func() tfprotov5.ProviderServer { return mux.ProviderServer() })
+}
+
+// frameworkProvider is the part of the provider implemented with the
+// Terraform plugin framework: the resources migrated from SDKv2 and the
+// ephemeral resources, functions, actions and list resources SDKv2 cannot
+// express. It is served next to the SDKv2 provider by the mux of
+// ProviderServerFactory and shares its configuration: the SDKv2 provider is
+// configured first, and the framework provider hands its client on.
+type frameworkProvider struct {
+	sdk *schema.Provider
+}
+
+var (
+	_ provider.Provider                       = (*frameworkProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*frameworkProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*frameworkProvider)(nil)
+	_ provider.ProviderWithActions            = (*frameworkProvider)(nil)
+	_ provider.ProviderWithListResources      = (*frameworkProvider)(nil)
+)
+
+// frameworkResources returns constructors for the provider's framework
+// resources by type name.
+func frameworkResources() map[string]func() resource.Resource {
+	return map[string]func() resource.Resource{
+		"cloudconnexa_dns_record": newDNSRecordResource,
+		"cloudconnexa_route":      newRouteResource,
+	}
+}
+
+// providerFunctions returns constructors for the provider's functions,
+// called as `provider::cloudconnexa::<name>(...)` from Terraform 1.8+, by
+// name.
+func providerFunctions() map[string]func() function.Function {
+	return map[string]func() function.Function{
+		"cidr_overlaps":   func() function.Function { return cidrOverlapsFunction{} },
+		"normalize_route": func() function.Function { return normalizeRouteFunction{} },
+		"parse_profile":   func() function.Function { return parseProfileFunction{} },
+		"service_ports":   func() function.Function { return servicePortsFunction{} },
+	}
+}
+
+// sortedConstructors returns the values of constructors ordered by name.
+func sortedConstructors[T any](constructors map[string]func() T) []func() T {
+	sorted := make([]func() T, 0, len(constructors))
+	for _, name := range slices.Sorted(maps.Keys(constructors)) {
+		sorted = append(sorted, constructors[name])
+	}
+	return sorted
+}
+
+// Metadata implements provider.Provider.
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "cloudconnexa"
+}
+
+// Schema implements provider.Provider. The mux requires every server to
+// declare the same provider schema, so it is converted from the SDKv2 one.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes, blocks := frameworkProviderAttributes(p.sdk.Schema)
+	resp.Schema = providerschema.Schema{Attributes: attributes, Blocks: blocks}
+}
+
+// Configure implements provider.Provider, passing the client of the SDKv2
+// provider, which the mux has configured already, to the resources,
+// ephemeral resources, actions and list resources.
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	if meta := p.sdk.Meta(); meta != nil {
+		resp.ResourceData = meta
+		resp.EphemeralResourceData = meta
+		resp.ActionData = meta
+		resp.ListResourceData = meta
+	}
+}
+
+// Resources implements provider.Provider.
+func (p *frameworkProvider) Resources(context.Context) []func() resource.Resource {
+	return sortedConstructors(frameworkResources())
+}
+
+// DataSources implements provider.Provider. All data sources are served by
+// the SDKv2 provider.
+func (p *frameworkProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// EphemeralResources implements provider.ProviderWithEphemeralResources.
+func (p *frameworkProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return sortedConstructors(ephemeralResources())
+}
+
+// Functions implements provider.ProviderWithFunctions.
+func (p *frameworkProvider) Functions(context.Context) []func() function.Function {
+	return sortedConstructors(providerFunctions())
+}
+
+// Actions implements provider.ProviderWithActions.
+func (p *frameworkProvider) Actions(context.Context) []func() action.Action {
+	return sortedConstructors(providerActions())
+}
+
+// ListResources implements provider.ProviderWithListResources. The list
+// resources of SDKv2 resources take their resource schemas from p.sdk.
+func (p *frameworkProvider) ListResources(context.Context) []func() list.ListResource {
+	constructors := map[string]func() list.ListResource{}
+	for name, l := range listResources() {
+		constructors[name] = func() list.ListResource {
+			return &frameworkListResource{typeName: name, def: l, sdk: p.sdk}
+		}
+	}
+	return sortedConstructors(constructors)
+}
+
+// frameworkProviderAttributes converts the SDKv2 provider schema s to
+// framework attributes and blocks with the same protocol schema. Only the
+// kinds of arguments the provider schema uses are supported.
+func frameworkProviderAttributes(s map[string]*schema.Schema) (map[string]providerschema.Attribute, map[string]providerschema.Block) {
+	attributes := map[string]providerschema.Attribute{}
+	blocks := map[string]providerschema.Block{}
+	for name, as := range s {
+		switch as.Type {
+		case schema.TypeString:
+			attributes[name] = providerschema.StringAttribute{
+				Description: as.Description, Optional: as.Optional, Required: as.Required, Sensitive: as.Sensitive,
+			}
+		case schema.TypeBool:
+			attributes[name] = providerschema.BoolAttribute{
+				Description: as.Description, Optional: as.Optional, Required: as.Required, Sensitive: as.Sensitive,
+			}
+		case schema.TypeInt:
+			attributes[name] = providerschema.Int64Attribute{
+				Description: as.Description, Optional: as.Optional, Required: as.Required, Sensitive: as.Sensitive,
+			}
+		case schema.TypeFloat:
+			attributes[name] = providerschema.Float64Attribute{
+				Description: as.Description, Optional: as.Optional, Required: as.Required, Sensitive: as.Sensitive,
+			}
+		case schema.TypeList:
+			elem := as.Elem.(*schema.Resource)
+			nestedAttributes, nestedBlocks := frameworkProviderAttributes(elem.SchemaMap())
+			blocks[name] = providerschema.ListNestedBlock{
+				Description: as.Description,
+				NestedObject: providerschema.NestedBlockObject{
+					Attributes: nestedAttributes,
+					Blocks:     nestedBlocks,
+				},
+			}
+		}
+	}
+	return attributes, blocks
+}
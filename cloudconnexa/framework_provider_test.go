@@ -0,0 +1,174 @@
+package cloudconnexa
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnitProviderServer_ServesSDKProvider checks that the protocol server
+// exposes every SDKv2 resource and data source with unchanged schemas, next
+// to the framework resources, so existing state keeps working.
+func TestUnitProviderServer_ServesSDKProvider(t *testing.T) {
+	server, err := ProviderServerFactory()()
+	require.NoError(t, err)
+	_, ok := server.(tfprotov6.ProviderServerWithActions)
+	assert.True(t, ok)
+	_, ok = server.(tfprotov6.ProviderServerWithListResource)
+	assert.True(t, ok)
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	p := Provider()
+	assert.Len(t, resp.ResourceSchemas, len(p.ResourcesMap)+len(frameworkResources()))
+	assert.Len(t, resp.DataSourceSchemas, len(p.DataSourcesMap))
+	sdk, err := p.GRPCProvider().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for name, s := range sdk.ResourceSchemas {
+		assert.Equal(t, s.Version, resp.ResourceSchemas[name].Version, name)
+		assert.Equal(t, s.ValueType(), resp.ResourceSchemas[name].ValueType(), name)
+	}
+	assert.Equal(t, sdk.Provider.ValueType(), resp.Provider.ValueType())
+}
+
+// TestUnitProviderServer_FrameworkResourcesKeepState checks that the
+// resources migrated to the framework keep the schema version and state
+// type of their SDKv2 versions, so existing state decodes unchanged, and
+// keep their identity.
+func TestUnitProviderServer_FrameworkResourcesKeepState(t *testing.T) {
+	server, err := ProviderServerFactory()()
+	require.NoError(t, err)
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	identities, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+
+	str := tftypes.String
+	timeouts := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": str, "delete": str, "read": str, "update": str}}
+	for name, attributes := range map[string]map[string]tftypes.Type{
+		"cloudconnexa_route": {
+			"id": str, "type": str, "subnet": str, "network_item_id": str, "description": str, "timeouts": timeouts,
+		},
+		"cloudconnexa_dns_record": {
+			"id": str, "domain": str, "description": str, "timeouts": timeouts,
+			"ip_v4_addresses": tftypes.List{ElementType: str}, "ip_v6_addresses": tftypes.List{ElementType: str},
+		},
+	} {
+		require.Contains(t, resp.ResourceSchemas, name)
+		assert.Zero(t, resp.ResourceSchemas[name].Version, name)
+		assert.Equal(t, tftypes.Object{AttributeTypes: attributes}, resp.ResourceSchemas[name].ValueType(), name)
+		require.Contains(t, identities.IdentitySchemas, name)
+		assert.Equal(t, identityIDAttribute, identities.IdentitySchemas[name].IdentityAttributes[0].Name, name)
+	}
+}
+
+// stringValue returns a tftypes string value.
+func stringValue(s string) tftypes.Value {
+	return tftypes.NewValue(tftypes.String, s)
+}
+
+// frameworkTestServer is the protocol server of the framework provider,
+// including the action and list resource RPCs.
+type frameworkTestServer interface {
+	tfprotov5.ProviderServerWithActions
+	tfprotov5.ListResourceServer
+}
+
+// newFrameworkTestServer returns the protocol server of the framework
+// provider sharing the configuration of p, configured the way the mux does
+// after p, so its types receive the meta of p.
+func newFrameworkTestServer(t *testing.T, p *schema.Provider) frameworkTestServer {
+	t.Helper()
+	server, ok := providerserver.NewProtocol5(&frameworkProvider{sdk: p})().(frameworkTestServer)
+	require.True(t, ok)
+	ctx := context.Background()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemaResp.Diagnostics)
+	typ := schemaResp.Provider.ValueType()
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, nil))
+	require.NoError(t, err)
+	resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &config})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+	return server
+}
+
+// callTestFunction calls the provider function name through the provider
+// server with string arguments and returns the result or function error.
+func callTestFunction(t *testing.T, name string, args ...string) (tftypes.Value, *tfprotov6.FunctionError) {
+	t.Helper()
+	server, err := ProviderServerFactory()()
+	require.NoError(t, err)
+	ctx := context.Background()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	f, ok := schemas.Functions[name]
+	require.True(t, ok, name)
+
+	req := &tfprotov6.CallFunctionRequest{Name: name}
+	for _, arg := range args {
+		dv, err := tfprotov6.NewDynamicValue(tftypes.String, stringValue(arg))
+		require.NoError(t, err)
+		req.Arguments = append(req.Arguments, &dv)
+	}
+	resp, err := server.CallFunction(ctx, req)
+	require.NoError(t, err)
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error
+	}
+	v, err := resp.Result.Unmarshal(f.Return.Type)
+	require.NoError(t, err)
+	return v, nil
+}
+
+// TestUnitProviderServer_Metadata checks that the framework provider's
+// ephemeral resources, functions, actions and list resources are served.
+func TestUnitProviderServer_Metadata(t *testing.T) {
+	server, err := ProviderServerFactory()()
+	require.NoError(t, err)
+	meta, err := server.GetMetadata(context.Background(), &tfprotov6.GetMetadataRequest{})
+	require.NoError(t, err)
+	require.Empty(t, meta.Diagnostics)
+
+	assert.ElementsMatch(t, []tfprotov6.EphemeralResourceMetadata{
+		{TypeName: "cloudconnexa_host_connector_credentials"},
+		{TypeName: "cloudconnexa_network_connector_credentials"},
+	}, meta.EphemeralResources)
+	assert.ElementsMatch(t, []tfprotov6.FunctionMetadata{
+		{Name: "cidr_overlaps"}, {Name: "normalize_route"}, {Name: "parse_profile"}, {Name: "service_ports"},
+	}, meta.Functions)
+	assert.ElementsMatch(t, []tfprotov6.ActionMetadata{
+		{TypeName: "cloudconnexa_connector_activate"},
+		{TypeName: "cloudconnexa_connector_restart_ipsec"},
+		{TypeName: "cloudconnexa_connector_suspend"},
+	}, meta.Actions)
+	assert.Len(t, meta.ListResources, len(listResources()))
+}
+
+// TestUnitProviderServer_Functions checks that the provider functions are
+// advertised in the schema and reject a wrong number of arguments.
+func TestUnitProviderServer_Functions(t *testing.T) {
+	server, err := ProviderServerFactory()()
+	require.NoError(t, err)
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.Len(t, schemaResp.Functions, 4)
+	functions, err := server.GetFunctions(context.Background(), &tfprotov6.GetFunctionsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, schemaResp.Functions, functions.Functions)
+
+	resp, err := server.CallFunction(context.Background(), &tfprotov6.CallFunctionRequest{Name: "normalize_route"})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Text, "Expected function arguments: 1")
+}
@@ -0,0 +1,247 @@
+package cloudconnexa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// errProviderNotConfigured is returned when a framework type needs the API
+// client before the provider was configured.
+var errProviderNotConfigured = errors.New("the provider has not been configured")
+
+// frameworkResource implements the parts common to the provider's framework
+// resources: the type name, the client passed by frameworkProvider, the
+// identity made of the CloudConnexa ID and import by ID or identity.
+type frameworkResource struct {
+	typeName string
+	client   *cloudconnexa.Client
+}
+
+// Metadata implements resource.Resource.
+func (r *frameworkResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *frameworkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if c := providerDataClient(req.ProviderData, &resp.Diagnostics); c != nil {
+		r.client = c
+	}
+}
+
+// providerDataClient returns the client frameworkProvider passes to its
+// types as provider data. It is nil until the provider is configured, and
+// after adding an error to diags if the data is of another type.
+func providerDataClient(providerData any, diags *fwdiag.Diagnostics) *cloudconnexa.Client {
+	if providerData == nil {
+		return nil
+	}
+	c, ok := providerData.(*cloudconnexa.Client)
+	if !ok {
+		diags.AddError("Unexpected provider data", fmt.Sprintf("Expected *cloudconnexa.Client, got %T.", providerData))
+		return nil
+	}
+	return c
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity with the same
+// identity as withIDIdentity gives SDKv2 resources.
+func (r *frameworkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			identityIDAttribute: identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the resource.",
+			},
+		},
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState, importing by ID
+// or by identity.
+func (r *frameworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root(identityIDAttribute), req, resp)
+}
+
+// clientFor returns the client bound to ctx, or nil after adding an error
+// to diags if the provider has not been configured.
+func (r *frameworkResource) clientFor(ctx context.Context, diags *fwdiag.Diagnostics) *cloudconnexa.Client {
+	if r.client == nil {
+		diags.AddError("Unable to access "+r.typeName, errProviderNotConfigured.Error())
+		return nil
+	}
+	return clientFromMeta(ctx, r.client)
+}
+
+// writableClientFor is clientFor for the modifying operation, e.g.
+// "create", which fails as guardReadOnly does in read-only mode.
+func (r *frameworkResource) writableClientFor(ctx context.Context, operation string, diags *fwdiag.Diagnostics) *cloudconnexa.Client {
+	if isReadOnly(r.client) {
+		for _, d := range readOnlyDiagnostics(r.typeName, operation) {
+			diags.AddError(d.Summary, d.Detail)
+		}
+		return nil
+	}
+	return r.clientFor(ctx, diags)
+}
+
+// setIdentity sets the identity of the object id in identity, which is nil
+// when the resource is read outside of Terraform, e.g. for export.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id string, diags *fwdiag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+	diags.Append(identity.SetAttribute(ctx, path.Root(identityIDAttribute), id)...)
+}
+
+// readFrameworkObject reads the object id through the Read method of r, a
+// framework resource, the way listResource.read does for SDKv2 resources.
+// The value is null if the object no longer exists.
+//
+// Parameters:
+//   - ctx: The context for the operation
+//   - r: The resource to read with
+//   - meta: The provider meta, the configured client
+//   - id: The ID of the object
+//
+// Returns:
+//   - cty.Value: The state of the object
+//   - error: An error if the object could not be read
+func readFrameworkObject(ctx context.Context, r resource.Resource, meta interface{}, id string) (cty.Value, error) {
+	if rc, ok := r.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		rc.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &resp)
+		if err := frameworkDiagnosticsError(resp.Diagnostics); err != nil {
+			return cty.NilVal, err
+		}
+	}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	ty := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+	for name, at := range ty.AttributeTypes {
+		attrs[name] = tftypes.NewValue(at, nil)
+	}
+	attrs["id"] = tftypes.NewValue(tftypes.String, id)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(ty, attrs)}
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if err := frameworkDiagnosticsError(resp.Diagnostics); err != nil {
+		return cty.NilVal, err
+	}
+	if resp.State.Raw.IsNull() {
+		return cty.NullVal(ctyType(ty)), nil
+	}
+	dv, err := tfprotov6.NewDynamicValue(ty, resp.State.Raw)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return msgpack.Unmarshal(dv.MsgPack, ctyType(ty))
+}
+
+// frameworkDiagnosticsError returns the first error of diags, if any.
+func frameworkDiagnosticsError(diags fwdiag.Diagnostics) error {
+	for _, d := range diags.Errors() {
+		return fmt.Errorf("%s: %s", d.Summary(), d.Detail())
+	}
+	return nil
+}
+
+// ctyType returns the cty type of the Terraform type t.
+func ctyType(t tftypes.Type) cty.Type {
+	switch t := t.(type) {
+	case tftypes.List:
+		return cty.List(ctyType(t.ElementType))
+	case tftypes.Set:
+		return cty.Set(ctyType(t.ElementType))
+	case tftypes.Map:
+		return cty.Map(ctyType(t.ElementType))
+	case tftypes.Tuple:
+		elems := make([]cty.Type, len(t.ElementTypes))
+		for i, et := range t.ElementTypes {
+			elems[i] = ctyType(et)
+		}
+		return cty.Tuple(elems)
+	case tftypes.Object:
+		attrs := map[string]cty.Type{}
+		for name, at := range t.AttributeTypes {
+			attrs[name] = ctyType(at)
+		}
+		return cty.Object(attrs)
+	}
+	switch {
+	case t.Is(tftypes.String):
+		return cty.String
+	case t.Is(tftypes.Number):
+		return cty.Number
+	case t.Is(tftypes.Bool):
+		return cty.Bool
+	}
+	return cty.DynamicPseudoType
+}
+
+// stringList returns values as a list of strings. An empty list is returned
+// as prior if that is null, so that an unset optional list does not show a
+// difference when the API returns an empty one.
+func stringList(ctx context.Context, values []string, prior types.List, diags *fwdiag.Diagnostics) types.List {
+	if len(values) == 0 && prior.IsNull() {
+		return prior
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return list
+}
+
+// listStrings returns the strings of list, an empty slice if it is null.
+func listStrings(ctx context.Context, list types.List, diags *fwdiag.Diagnostics) []string {
+	values := make([]string, 0)
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// ipAddressValidator checks that a string is an IP address, with the same
+// rules as the SDKv2 validation.IsIPv4Address and validation.IsIPv6Address.
+type ipAddressValidator struct {
+	// version is 4 to only accept IPv4 addresses, or 6.
+	version int
+}
+
+var _ validator.String = ipAddressValidator{}
+
+// Description implements validator.String.
+func (v ipAddressValidator) Description(context.Context) string {
+	return fmt.Sprintf("value must be a valid IPv%d address", v.version)
+}
+
+// MarkdownDescription implements validator.String.
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements validator.String.
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	ip := net.ParseIP(req.ConfigValue.ValueString())
+	if ip == nil || (v.version == 4 && ip.To4() == nil) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IP address",
+			fmt.Sprintf("Expected %s to contain a valid IPv%d address, got: %s", req.Path, v.version, req.ConfigValue.ValueString()))
+	}
+}
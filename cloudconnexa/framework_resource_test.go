@@ -0,0 +1,206 @@
+package cloudconnexa
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// frameworkTestValue returns an object of the schema of r with the given
+// attributes and all others null.
+func frameworkTestValue(t *testing.T, r resource.Resource, attrs map[string]tftypes.Value) (resource.SchemaResponse, tftypes.Value) {
+	t.Helper()
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+	ty := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, at := range ty.AttributeTypes {
+		values[name] = tftypes.NewValue(at, nil)
+	}
+	for name, v := range attrs {
+		values[name] = v
+	}
+	return resp, tftypes.NewValue(ty, values)
+}
+
+// frameworkTestIdentity returns an empty identity for r.
+func frameworkTestIdentity(t *testing.T, r resource.ResourceWithIdentity) *tfsdk.ResourceIdentity {
+	t.Helper()
+	var resp resource.IdentitySchemaResponse
+	r.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, &resp)
+	return &tfsdk.ResourceIdentity{
+		Schema: resp.IdentitySchema,
+		Raw:    tftypes.NewValue(resp.IdentitySchema.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+// routeTestPlan returns the plan of a new route.
+func routeTestPlan(t *testing.T, r resource.Resource) tfsdk.Plan {
+	t.Helper()
+	s, v := frameworkTestValue(t, r, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"type":            stringValue("IP_V4"),
+		"subnet":          stringValue("10.0.0.0/24"),
+		"network_item_id": stringValue("network-1"),
+		"description":     stringValue("Managed by Terraform"),
+	})
+	return tfsdk.Plan{Schema: s.Schema, Raw: v}
+}
+
+// TestUnitRouteResource_Create checks that the created route is stored in
+// state with its ID and identity.
+func TestUnitRouteResource_Create(t *testing.T) {
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/networks/routes", r.URL.Path)
+		assert.Equal(t, "network-1", r.URL.Query().Get("networkId"))
+		_, _ = w.Write([]byte(`{"id":"route-1","type":"IP_V4","subnet":"10.0.0.0/24","description":"Managed by Terraform"}`))
+	}))
+	c.UpdateRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	r := newRouteResource().(*routeResource)
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: c}, &resource.ConfigureResponse{})
+
+	plan := routeTestPlan(t, r)
+	resp := resource.CreateResponse{
+		State:    tfsdk.State{Schema: plan.Schema, Raw: plan.Raw},
+		Identity: frameworkTestIdentity(t, r),
+	}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var id types.String
+	resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	assert.Equal(t, "route-1", id.ValueString())
+	resp.Identity.GetAttribute(context.Background(), path.Root("id"), &id)
+	assert.Equal(t, "route-1", id.ValueString())
+}
+
+// TestUnitRouteResource_Update checks that a description change updates
+// the route in place and keeps its subnet.
+func TestUnitRouteResource_Update(t *testing.T) {
+	var calls atomic.Int32
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/networks/routes/route-1", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"description":"Updated","value":"10.0.0.0/24"}`, string(body))
+	}))
+	c.UpdateRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	r := newRouteResource().(*routeResource)
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: c}, &resource.ConfigureResponse{})
+
+	s, stateValue := frameworkTestValue(t, r, map[string]tftypes.Value{
+		"id":              stringValue("route-1"),
+		"type":            stringValue("IP_V4"),
+		"subnet":          stringValue("10.0.0.0/24"),
+		"network_item_id": stringValue("network-1"),
+		"description":     stringValue("Managed by Terraform"),
+	})
+	_, planValue := frameworkTestValue(t, r, map[string]tftypes.Value{
+		"id":              stringValue("route-1"),
+		"type":            stringValue("IP_V4"),
+		"subnet":          stringValue("10.0.0.0/24"),
+		"network_item_id": stringValue("network-1"),
+		"description":     stringValue("Updated"),
+	})
+	state := tfsdk.State{Schema: s.Schema, Raw: stateValue}
+	resp := resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s.Schema, Raw: planValue},
+		State: state,
+	}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.EqualValues(t, 1, calls.Load())
+
+	var description types.String
+	resp.State.GetAttribute(context.Background(), path.Root("description"), &description)
+	assert.Equal(t, "Updated", description.ValueString())
+}
+
+// TestUnitRouteResource_ReadOnly checks that a framework resource refuses
+// to create objects in read-only mode before any API call.
+func TestUnitRouteResource_ReadOnly(t *testing.T) {
+	var calls atomic.Int32
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+	}))
+	r := newRouteResource().(*routeResource)
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: enableReadOnly(c)}, &resource.ConfigureResponse{})
+
+	plan := routeTestPlan(t, r)
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "CloudConnexa provider is in read-only mode", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "create cloudconnexa_route")
+	assert.Zero(t, calls.Load())
+}
+
+// TestUnitRouteResource_ReadNotFound checks that a route that no longer
+// exists is removed from state.
+func TestUnitRouteResource_ReadNotFound(t *testing.T) {
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"content":[],"totalPages":1}`))
+	}))
+	c.ReadRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	r := newRouteResource().(*routeResource)
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: c}, &resource.ConfigureResponse{})
+
+	s, v := frameworkTestValue(t, r, map[string]tftypes.Value{"id": stringValue("route-1")})
+	state := tfsdk.State{Schema: s.Schema, Raw: v}
+	resp := resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+// TestUnitDNSRecordResource_ValidateConfig checks that at least one
+// non-empty address list is required unless the lists are not known yet.
+func TestUnitDNSRecordResource_ValidateConfig(t *testing.T) {
+	list := tftypes.List{ElementType: tftypes.String}
+	addresses := func(values ...string) tftypes.Value {
+		var elems []tftypes.Value
+		for _, v := range values {
+			elems = append(elems, stringValue(v))
+		}
+		return tftypes.NewValue(list, elems)
+	}
+	for name, tc := range map[string]struct {
+		v4, v6  tftypes.Value
+		wantErr bool
+	}{
+		"ipv4":        {v4: addresses("10.0.0.1"), v6: tftypes.NewValue(list, nil)},
+		"ipv6":        {v4: tftypes.NewValue(list, nil), v6: addresses("fd00::1")},
+		"unset":       {v4: tftypes.NewValue(list, nil), v6: tftypes.NewValue(list, nil), wantErr: true},
+		"empty":       {v4: addresses(), v6: tftypes.NewValue(list, nil), wantErr: true},
+		"not known":   {v4: tftypes.NewValue(list, tftypes.UnknownValue), v6: addresses()},
+		"both listed": {v4: addresses("10.0.0.1"), v6: addresses("fd00::1")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := newDNSRecordResource().(*dnsRecordResource)
+			s, v := frameworkTestValue(t, r, map[string]tftypes.Value{
+				"domain":          stringValue("app.example.com"),
+				"ip_v4_addresses": tc.v4,
+				"ip_v6_addresses": tc.v6,
+			})
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s.Schema, Raw: v},
+			}, &resp)
+			assert.Equal(t, tc.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
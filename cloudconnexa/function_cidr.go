@@ -0,0 +1,115 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// parseRoute parses a route subnet the way the CloudConnexa API accepts it:
+// a CIDR or a bare address, which is treated as a host route (/32 or /128).
+// Host bits are cleared, as the API does when it stores the route.
+func parseRoute(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("%q is not a valid IP address or CIDR", s)
+		}
+		return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR", s)
+	}
+	if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+	return prefix.Masked(), nil
+}
+
+// cidrOverlapsFunction is the `cidr_overlaps(a, b)` function, which
+// reports whether two routes share any address, e.g. to check a route
+// against a network's `system_subnets`.
+type cidrOverlapsFunction struct{}
+
+var _ function.Function = cidrOverlapsFunction{}
+
+// Metadata implements function.Function.
+func (cidrOverlapsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+// Definition implements function.Function.
+func (cidrOverlapsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether two CIDR blocks overlap",
+		Description: "Returns `true` if the CIDR blocks `a` and `b` have any address in common. Bare IP addresses are treated as host routes. Blocks of different IP versions never overlap.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "a", Description: "The first CIDR block."},
+			function.StringParameter{Name: "b", Description: "The second CIDR block."},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run implements function.Function.
+func (cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+	if resp.Error != nil {
+		return
+	}
+	prefixes := make([]netip.Prefix, 2)
+	for i, s := range []string{a, b} {
+		p, err := parseRoute(s)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(int64(i), err.Error())
+			return
+		}
+		prefixes[i] = p
+	}
+	resp.Error = resp.Result.Set(ctx, prefixes[0].Overlaps(prefixes[1]))
+}
+
+// normalizeRouteFunction is the `normalize_route(cidr)` function, which
+// canonicalizes a route subnet like the API does, so configuration can be
+// compared with the subnets the API returns.
+type normalizeRouteFunction struct{}
+
+var _ function.Function = normalizeRouteFunction{}
+
+// Metadata implements function.Function.
+func (normalizeRouteFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_route"
+}
+
+// Definition implements function.Function.
+func (normalizeRouteFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Canonicalize a route subnet",
+		Description: "Returns `cidr` in the form the CloudConnexa API stores it: host bits cleared, IPv6 addresses compressed and lower-case, and bare IP addresses turned into `/32` or `/128` host routes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "cidr", Description: "The CIDR block or IP address to canonicalize."},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run implements function.Function.
+func (normalizeRouteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	resp.Error = req.Arguments.Get(ctx, &cidr)
+	if resp.Error != nil {
+		return
+	}
+	p, err := parseRoute(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, p.String())
+}
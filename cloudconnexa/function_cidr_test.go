@@ -0,0 +1,61 @@
+package cloudconnexa
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnitNormalizeRouteFunction covers the canonical forms of IPv4 and IPv6
+// routes and invalid input.
+func TestUnitNormalizeRouteFunction(t *testing.T) {
+	for in, want := range map[string]string{
+		"10.1.2.3/16":          "10.1.0.0/16",
+		" 192.168.0.0/24 ":     "192.168.0.0/24",
+		"10.0.0.1":             "10.0.0.1/32",
+		"2001:DB8:0:0::1/64":   "2001:db8::/64",
+		"2001:db8::1":          "2001:db8::1/128",
+		"::ffff:10.0.0.0/104":  "10.0.0.0/8",
+		"0.0.0.0/0":            "0.0.0.0/0",
+		"fd00:0:0:0:0:0:0:0/8": "fd00::/8",
+	} {
+		v, ferr := callTestFunction(t, "normalize_route", in)
+		require.Nil(t, ferr, in)
+		var got string
+		require.NoError(t, v.As(&got))
+		assert.Equal(t, want, got, in)
+	}
+
+	for _, in := range []string{"", "10.0.0.0/33", "example.com", "10.0.0/8"} {
+		_, ferr := callTestFunction(t, "normalize_route", in)
+		require.NotNil(t, ferr, in)
+		assert.EqualValues(t, 0, *ferr.FunctionArgument, in)
+	}
+}
+
+// TestUnitCIDROverlapsFunction covers overlapping, disjoint and mixed-version
+// CIDR blocks.
+func TestUnitCIDROverlapsFunction(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want bool
+	}{
+		{"10.0.0.0/8", "10.20.0.0/16", true},
+		{"10.20.0.0/16", "10.0.0.0/8", true},
+		{"10.0.0.0/16", "10.1.0.0/16", false},
+		{"100.96.0.0/11", "100.96.1.1", true},
+		{"2001:db8::/32", "2001:db8:1::/48", true},
+		{"10.0.0.0/8", "2001:db8::/32", false},
+	} {
+		v, ferr := callTestFunction(t, "cidr_overlaps", tc.a, tc.b)
+		require.Nil(t, ferr, "%s %s", tc.a, tc.b)
+		var got bool
+		require.NoError(t, v.As(&got))
+		assert.Equal(t, tc.want, got, "%s %s", tc.a, tc.b)
+	}
+
+	_, ferr := callTestFunction(t, "cidr_overlaps", "10.0.0.0/8", "invalid")
+	require.NotNil(t, ferr)
+	assert.EqualValues(t, 1, *ferr.FunctionArgument)
+}
@@ -0,0 +1,250 @@
+package cloudconnexa
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultOpenVPNPort and defaultOpenVPNProtocol apply when a profile sets
+// neither `port`/`proto` nor a port or protocol on its `remote` lines.
+const (
+	defaultOpenVPNPort     = 1194
+	defaultOpenVPNProtocol = "udp"
+)
+
+// profileRemote is a `remote` entry of an OpenVPN profile.
+type profileRemote struct {
+	host     string
+	port     int
+	protocol string
+}
+
+// openVPNProfile holds the connection settings of an OpenVPN profile.
+type openVPNProfile struct {
+	remotes       []profileRemote
+	protocol      string
+	cipher        string
+	caFingerprint string
+}
+
+// parseOpenVPNProfile extracts the connection settings from the text of a
+// connector `profile`.
+func parseOpenVPNProfile(text string) (*openVPNProfile, error) {
+	p := &openVPNProfile{}
+	port := defaultOpenVPNPort
+	var remotes [][]string
+	var dataCiphers string
+	var ca strings.Builder
+	inCA := false
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "<ca>":
+			inCA = true
+			continue
+		case line == "</ca>":
+			inCA = false
+			continue
+		case inCA:
+			ca.WriteString(line + "\n")
+			continue
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "remote":
+			remotes = append(remotes, fields[1:])
+		case "proto":
+			if len(fields) > 1 {
+				p.protocol = normalizeOpenVPNProtocol(fields[1])
+			}
+		case "port":
+			if len(fields) > 1 {
+				n, err := strconv.Atoi(fields[1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid port %q", fields[1])
+				}
+				port = n
+			}
+		case "cipher":
+			if len(fields) > 1 {
+				p.cipher = fields[1]
+			}
+		case "data-ciphers", "ncp-ciphers":
+			if len(fields) > 1 {
+				dataCiphers = fields[1]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(remotes) == 0 {
+		return nil, errors.New("the profile contains no remote")
+	}
+	if p.protocol == "" {
+		p.protocol = defaultOpenVPNProtocol
+	}
+	if p.cipher == "" && dataCiphers != "" {
+		p.cipher, _, _ = strings.Cut(dataCiphers, ":")
+	}
+
+	for _, fields := range remotes {
+		if len(fields) == 0 {
+			return nil, errors.New("the profile contains a remote without host")
+		}
+		r := profileRemote{host: fields[0], port: port, protocol: p.protocol}
+		if len(fields) > 1 {
+			n, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid port %q of remote %s", fields[1], fields[0])
+			}
+			r.port = n
+		}
+		if len(fields) > 2 {
+			r.protocol = normalizeOpenVPNProtocol(fields[2])
+		}
+		p.remotes = append(p.remotes, r)
+	}
+
+	if ca.Len() > 0 {
+		block, _ := pem.Decode([]byte(ca.String()))
+		if block == nil {
+			return nil, errors.New("the <ca> block contains no PEM certificate")
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, fmt.Errorf("invalid CA certificate: %w", err)
+		}
+		p.caFingerprint = certificateFingerprint(block.Bytes)
+	}
+	return p, nil
+}
+
+// normalizeOpenVPNProtocol maps OpenVPN protocol names such as `tcp-client`
+// or `udp4` to `tcp` or `udp`.
+func normalizeOpenVPNProtocol(proto string) string {
+	proto = strings.ToLower(proto)
+	switch {
+	case strings.HasPrefix(proto, "tcp"):
+		return "tcp"
+	case strings.HasPrefix(proto, "udp"):
+		return "udp"
+	}
+	return proto
+}
+
+// certificateFingerprint returns the SHA-256 fingerprint of a DER
+// certificate as colon-separated upper-case hex, as printed by openssl.
+func certificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
+}
+
+// profileRemoteModel and profileModel are the parse_profile result.
+type (
+	profileRemoteModel struct {
+		Host     types.String `tfsdk:"host"`
+		Port     types.Int64  `tfsdk:"port"`
+		Protocol types.String `tfsdk:"protocol"`
+	}
+	profileModel struct {
+		Remotes       []profileRemoteModel `tfsdk:"remotes"`
+		Ports         []int64              `tfsdk:"ports"`
+		Protocol      types.String         `tfsdk:"protocol"`
+		Cipher        types.String         `tfsdk:"cipher"`
+		CAFingerprint types.String         `tfsdk:"ca_fingerprint"`
+	}
+)
+
+// profileRemoteType is the object type of a parse_profile remote.
+var profileRemoteType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"host":     types.StringType,
+	"port":     types.Int64Type,
+	"protocol": types.StringType,
+}}
+
+// model converts the profile to a parse_profile result.
+func (p *openVPNProfile) model() profileModel {
+	m := profileModel{
+		Remotes:       make([]profileRemoteModel, 0, len(p.remotes)),
+		Ports:         make([]int64, 0, len(p.remotes)),
+		Protocol:      types.StringValue(p.protocol),
+		Cipher:        types.StringValue(p.cipher),
+		CAFingerprint: types.StringValue(p.caFingerprint),
+	}
+	for _, r := range p.remotes {
+		m.Remotes = append(m.Remotes, profileRemoteModel{
+			Host:     types.StringValue(r.host),
+			Port:     types.Int64Value(int64(r.port)),
+			Protocol: types.StringValue(r.protocol),
+		})
+		if !slices.Contains(m.Ports, int64(r.port)) {
+			m.Ports = append(m.Ports, int64(r.port))
+		}
+	}
+	slices.Sort(m.Ports)
+	return m
+}
+
+// parseProfileFunction is the `parse_profile(profile)` function.
+type parseProfileFunction struct{}
+
+var _ function.Function = parseProfileFunction{}
+
+// Metadata implements function.Function.
+func (parseProfileFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_profile"
+}
+
+// Definition implements function.Function.
+func (parseProfileFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Extract connection settings from a connector profile",
+		Description: "Parses the OpenVPN `profile` of a connector and returns its `remotes` (objects with `host`, `port` and `protocol`), the distinct remote `ports`, the default `protocol`, the `cipher` and the SHA-256 `ca_fingerprint` of the CA certificate as colon-separated hex. `ca_fingerprint` is empty if the profile embeds no CA.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "profile", Description: "The text of an OpenVPN profile."},
+		},
+		Return: function.ObjectReturn{AttributeTypes: map[string]attr.Type{
+			"remotes":        types.ListType{ElemType: profileRemoteType},
+			"ports":          types.ListType{ElemType: types.Int64Type},
+			"protocol":       types.StringType,
+			"cipher":         types.StringType,
+			"ca_fingerprint": types.StringType,
+		}},
+	}
+}
+
+// Run implements function.Function.
+func (parseProfileFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var profile string
+	resp.Error = req.Arguments.Get(ctx, &profile)
+	if resp.Error != nil {
+		return
+	}
+	p, err := parseOpenVPNProfile(profile)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid profile: "+err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, p.model())
+}
@@ -0,0 +1,102 @@
+package cloudconnexa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCACertificate returns a self-signed certificate as PEM and DER.
+func testCACertificate(t *testing.T) (string, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "CloudConnexa Test CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), der
+}
+
+// TestUnitParseOpenVPNProfile checks remotes, ports, protocol, cipher and
+// the CA fingerprint of a typical connector profile.
+func TestUnitParseOpenVPNProfile(t *testing.T) {
+	caPEM, der := testCACertificate(t)
+	profile := strings.Join([]string{
+		"# CloudConnexa connector profile",
+		"client",
+		"dev tun",
+		"proto udp",
+		"remote us-east-1.example.openvpn.com 1194",
+		"remote us-east-1.example.openvpn.com 443 tcp-client",
+		"remote us-west-1.example.openvpn.com",
+		"; remote commented.example.com 1",
+		"data-ciphers AES-256-GCM:AES-128-GCM",
+		"<ca>",
+		strings.TrimSpace(caPEM),
+		"</ca>",
+	}, "\n")
+
+	p, err := parseOpenVPNProfile(profile)
+	require.NoError(t, err)
+	assert.Equal(t, []profileRemote{
+		{host: "us-east-1.example.openvpn.com", port: 1194, protocol: "udp"},
+		{host: "us-east-1.example.openvpn.com", port: 443, protocol: "tcp"},
+		{host: "us-west-1.example.openvpn.com", port: 1194, protocol: "udp"},
+	}, p.remotes)
+	assert.Equal(t, "udp", p.protocol)
+	assert.Equal(t, "AES-256-GCM", p.cipher)
+	sum := sha256.Sum256(der)
+	assert.Equal(t, strings.ToUpper(hex.EncodeToString(sum[:])), strings.ReplaceAll(p.caFingerprint, ":", ""))
+	assert.Len(t, strings.Split(p.caFingerprint, ":"), sha256.Size)
+}
+
+// TestUnitParseProfileFunction checks the function result and its errors.
+func TestUnitParseProfileFunction(t *testing.T) {
+	v, ferr := callTestFunction(t, "parse_profile", "remote vpn.example.com 443 tcp\nport 1194\ncipher AES-256-CBC\n")
+	require.Nil(t, ferr)
+	var attrs map[string]tftypes.Value
+	require.NoError(t, v.As(&attrs))
+	var protocol, cipher, fingerprint string
+	require.NoError(t, attrs["protocol"].As(&protocol))
+	require.NoError(t, attrs["cipher"].As(&cipher))
+	require.NoError(t, attrs["ca_fingerprint"].As(&fingerprint))
+	assert.Equal(t, "udp", protocol)
+	assert.Equal(t, "AES-256-CBC", cipher)
+	assert.Empty(t, fingerprint)
+	var ports []tftypes.Value
+	require.NoError(t, attrs["ports"].As(&ports))
+	require.Len(t, ports, 1)
+	var port big.Float
+	require.NoError(t, ports[0].As(&port))
+	assert.Equal(t, "443", port.String())
+
+	for profile, msg := range map[string]string{
+		"client\ndev tun\n":                            "no remote",
+		"remote vpn.example.com https\n":               "invalid port",
+		"remote vpn.example.com\n<ca>\nnot pem\n</ca>": "no PEM certificate",
+	} {
+		_, ferr := callTestFunction(t, "parse_profile", profile)
+		require.NotNil(t, ferr, profile)
+		assert.Contains(t, ferr.Text, msg)
+	}
+}
@@ -0,0 +1,106 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// servicePort is a protocol and port range, in the shape of the IP service
+// `custom_service_types` block. ICMP has no ports.
+type servicePort struct {
+	protocol string
+	fromPort int
+	toPort   int
+}
+
+// servicePorts maps every entry of validValues to the protocols and ports it
+// stands for.
+var servicePorts = map[string][]servicePort{
+	"ANY":    {{"TCP", 1, 65535}, {"UDP", 1, 65535}, {"ICMP", 0, 0}},
+	"BGP":    {{"TCP", 179, 179}},
+	"DHCP":   {{"UDP", 67, 68}},
+	"DNS":    {{"TCP", 53, 53}, {"UDP", 53, 53}},
+	"FTP":    {{"TCP", 20, 21}},
+	"HTTP":   {{"TCP", 80, 80}},
+	"HTTPS":  {{"TCP", 443, 443}},
+	"IMAP":   {{"TCP", 143, 143}},
+	"IMAPS":  {{"TCP", 993, 993}},
+	"NTP":    {{"UDP", 123, 123}},
+	"POP3":   {{"TCP", 110, 110}},
+	"POP3S":  {{"TCP", 995, 995}},
+	"SMTP":   {{"TCP", 25, 25}},
+	"SMTPS":  {{"TCP", 465, 465}},
+	"SNMP":   {{"UDP", 161, 162}},
+	"SSH":    {{"TCP", 22, 22}},
+	"TELNET": {{"TCP", 23, 23}},
+	"TFTP":   {{"UDP", 69, 69}},
+}
+
+// servicePortModel is a service_ports result element.
+type servicePortModel struct {
+	Protocol types.String `tfsdk:"protocol"`
+	FromPort types.Int64  `tfsdk:"from_port"`
+	ToPort   types.Int64  `tfsdk:"to_port"`
+}
+
+// servicePortType is the object type of a service_ports result element.
+var servicePortType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"protocol":  types.StringType,
+	"from_port": types.Int64Type,
+	"to_port":   types.Int64Type,
+}}
+
+// servicePortsFunction is the `service_ports(service_type)` function, which
+// expands a predefined IP service type into protocols and ports.
+type servicePortsFunction struct{}
+
+var _ function.Function = servicePortsFunction{}
+
+// Metadata implements function.Function.
+func (servicePortsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_ports"
+}
+
+// Definition implements function.Function.
+func (servicePortsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Expand a predefined service type into protocols and ports",
+		Description: "Returns the protocols and port ranges of a predefined `service_types` value such as `SSH` or `HTTPS`, as a list of objects with `protocol`, `from_port` and `to_port`, like the `custom_service_types` block. ICMP entries have null ports.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "service_type", Description: "A predefined service type, e.g. `SSH`."},
+		},
+		Return: function.ListReturn{ElementType: servicePortType},
+	}
+}
+
+// Run implements function.Function.
+func (servicePortsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var serviceType string
+	resp.Error = req.Arguments.Get(ctx, &serviceType)
+	if resp.Error != nil {
+		return
+	}
+	ports, ok := servicePorts[serviceType]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("service type must be one of %s", validValues))
+		return
+	}
+	elems := make([]servicePortModel, 0, len(ports))
+	for _, p := range ports {
+		elem := servicePortModel{
+			Protocol: types.StringValue(p.protocol),
+			FromPort: types.Int64Null(),
+			ToPort:   types.Int64Null(),
+		}
+		if p.protocol != "ICMP" {
+			elem.FromPort = types.Int64Value(int64(p.fromPort))
+			elem.ToPort = types.Int64Value(int64(p.toPort))
+		}
+		elems = append(elems, elem)
+	}
+	resp.Error = resp.Result.Set(ctx, elems)
+}
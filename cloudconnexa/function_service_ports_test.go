@@ -0,0 +1,51 @@
+package cloudconnexa
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnitServicePortsFunction checks single and multi-protocol service
+// types and that ICMP has null ports.
+func TestUnitServicePortsFunction(t *testing.T) {
+	v, ferr := callTestFunction(t, "service_ports", "SSH")
+	require.Nil(t, ferr)
+	var elems []tftypes.Value
+	require.NoError(t, v.As(&elems))
+	require.Len(t, elems, 1)
+	var ssh map[string]tftypes.Value
+	require.NoError(t, elems[0].As(&ssh))
+	var protocol string
+	var from, to big.Float
+	require.NoError(t, ssh["protocol"].As(&protocol))
+	require.NoError(t, ssh["from_port"].As(&from))
+	require.NoError(t, ssh["to_port"].As(&to))
+	assert.Equal(t, "TCP", protocol)
+	assert.Equal(t, "22", from.String())
+	assert.Equal(t, "22", to.String())
+
+	v, ferr = callTestFunction(t, "service_ports", "ANY")
+	require.Nil(t, ferr)
+	require.NoError(t, v.As(&elems))
+	require.Len(t, elems, 3)
+	var icmp map[string]tftypes.Value
+	require.NoError(t, elems[2].As(&icmp))
+	assert.True(t, icmp["from_port"].IsNull())
+
+	_, ferr = callTestFunction(t, "service_ports", "GOPHER")
+	require.NotNil(t, ferr)
+	assert.Contains(t, ferr.Text, "service type must be one of")
+}
+
+// TestUnitServicePortsCoverValidValues checks that every service type
+// accepted by the IP service resources has a mapping.
+func TestUnitServicePortsCoverValidValues(t *testing.T) {
+	for _, v := range validValues {
+		assert.NotEmpty(t, servicePorts[v], v)
+	}
+	assert.Len(t, servicePorts, len(validValues))
+}
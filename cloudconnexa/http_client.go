@@ -0,0 +1,37 @@
+package cloudconnexa
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// defaultResponseHeaderTimeout bounds how long a single API attempt may wait
+// for response headers. It replaces the 30 second http.Client timeout used by
+// the go-client, which would otherwise also cover retries and their backoff.
+const defaultResponseHeaderTimeout = 30 * time.Second
+
+// newBaseTransport returns the innermost transport used for every API
+// request: a clone of http.DefaultTransport with a per-attempt header timeout.
+func newBaseTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.ResponseHeaderTimeout = defaultResponseHeaderTimeout
+	return t
+}
+
+// clientFromMeta returns the provider's *cloudconnexa.Client bound to ctx:
+// every HTTP request made through the returned client carries ctx, so
+// Terraform timeouts and operator interrupts abort in-flight API calls. The
+// copy shares credentials, rate limiters and transports with the original.
+//
+// Parameters:
+//   - ctx: The context of the current CRUD operation
+//   - m: The provider meta interface
+//
+// Returns:
+//   - *cloudconnexa.Client: A client whose requests are bound to ctx
+func clientFromMeta(ctx context.Context, m interface{}) *cloudconnexa.Client {
+	return m.(*cloudconnexa.Client).WithContext(ctx)
+}
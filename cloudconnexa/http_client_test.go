@@ -0,0 +1,125 @@
+package cloudconnexa
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// recordingTransport counts the requests passing through it before handing
+// them to next, or http.DefaultTransport if next is nil.
+type recordingTransport struct {
+	calls int
+	next  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	if t.next != nil {
+		return t.next.RoundTrip(req)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// TestUnitNewTokenClient checks that requests issued by the go-client
+// services go through the HTTP client passed to newTokenClient.
+func TestUnitNewTokenClient(t *testing.T) {
+	server, _ := newTLSAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"host-id"}`))
+	}))
+	rt := &recordingTransport{next: server.Client().Transport}
+	c, err := newTokenClient(server.URL, "token", &http.Client{Transport: rt})
+	require.NoError(t, err)
+
+	_, err = c.Hosts.Get("host-id")
+	require.NoError(t, err)
+	assert.Equal(t, 1, rt.calls)
+	assert.Same(t, rt, c.HTTPClient().Transport)
+	assert.Contains(t, c.UserAgent, "terraform-provider-cloudconnexa/")
+}
+
+// TestUnitClientFromMeta_SharesTransport checks that the context-bound copy
+// keeps using the provider's transport chain while leaving the original
+// client untouched.
+func TestUnitClientFromMeta_SharesTransport(t *testing.T) {
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"host-id"}`))
+	}))
+	rt := &recordingTransport{}
+	original := &http.Client{Transport: rt}
+	c = c.WithHTTPClient(original)
+
+	bound := clientFromMeta(context.Background(), c)
+	require.NotSame(t, c, bound)
+	_, err := bound.Hosts.Get("host-id")
+	require.NoError(t, err)
+	_, err = bound.Networks.Get("network-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, rt.calls)
+	assert.Same(t, original, c.HTTPClient())
+	assert.Equal(t, c.BaseURL, bound.BaseURL)
+}
+
+// TestUnitClientFromMeta_DeadlineAbortsRequest checks that an operation
+// deadline interrupts a request the API never answers.
+func TestUnitClientFromMeta_DeadlineAbortsRequest(t *testing.T) {
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := clientFromMeta(ctx, c).Hosts.Get("host-id")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+// TestUnitClientFromMeta_DeadlineAbortsRateLimitWait checks that an
+// operation deadline also interrupts a wait for the go-client rate limiter.
+func TestUnitClientFromMeta_DeadlineAbortsRateLimitWait(t *testing.T) {
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"host-id"}`))
+	}))
+	c.ReadRateLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+	_, err := c.Hosts.Get("host-id")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = clientFromMeta(ctx, c).Hosts.Get("host-id")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+// TestUnitResourceHostRead_CancelledContext checks that a CRUD function run
+// with an already cancelled context fails without reaching the API.
+func TestUnitResourceHostRead_CancelledContext(t *testing.T) {
+	var calls int
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"id":"host-id"}`))
+	}))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	d := schema.TestResourceDataRaw(t, resourceHost().Schema, map[string]interface{}{"name": "x"})
+	d.SetId("host-id")
+	diags := resourceHostRead(ctx, d, c)
+	assert.True(t, diags.HasError())
+	assert.Zero(t, calls)
+}
@@ -0,0 +1,398 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// Keys of the `remote_peer_config` map.
+const (
+	peerConfigStrongSwan       = "strongswan"
+	peerConfigLibreswan        = "libreswan"
+	peerConfigLibreswanSecrets = "libreswan_secrets"
+	peerConfigCiscoIOS         = "cisco_ios"
+	peerConfigAWS              = "aws"
+)
+
+// Placeholders rendered for values the API does not provide: the server
+// address before the tunnel is provisioned, and secrets that are write-only
+// or not returned.
+const (
+	peerConfigServerIPPlaceholder = "<CLOUDCONNEXA_SERVER_IP>"
+	peerConfigPSKPlaceholder      = "<PRE_SHARED_KEY>"
+)
+
+// ipsecAlgorithms maps each algorithm or Diffie-Hellman group accepted by
+// ipSecConfigSchema to its name on every peer platform. A missing entry
+// means the platform does not support the value; it is left out of the
+// rendered configuration with a warning comment.
+var ipsecAlgorithms = map[string]map[string]string{
+	// Encryption. GCM algorithms are AEAD: they need no integrity algorithm
+	// in ESP and a PRF instead in IKE.
+	"AES128":        {peerConfigStrongSwan: "aes128", peerConfigLibreswan: "aes128", peerConfigCiscoIOS: "aes-cbc-128", peerConfigAWS: "AES128"},
+	"AES256":        {peerConfigStrongSwan: "aes256", peerConfigLibreswan: "aes256", peerConfigCiscoIOS: "aes-cbc-256", peerConfigAWS: "AES256"},
+	"AES128_GCM_16": {peerConfigStrongSwan: "aes128gcm16", peerConfigLibreswan: "aes_gcm128", peerConfigCiscoIOS: "aes-gcm-128", peerConfigAWS: "AES128-GCM-16"},
+	"AES256_GCM_16": {peerConfigStrongSwan: "aes256gcm16", peerConfigLibreswan: "aes_gcm256", peerConfigCiscoIOS: "aes-gcm-256", peerConfigAWS: "AES256-GCM-16"},
+	// Integrity.
+	"SHA1":     {peerConfigStrongSwan: "sha1", peerConfigLibreswan: "sha1", peerConfigCiscoIOS: "sha1", peerConfigAWS: "SHA1"},
+	"SHA2_256": {peerConfigStrongSwan: "sha256", peerConfigLibreswan: "sha2_256", peerConfigCiscoIOS: "sha256", peerConfigAWS: "SHA2-256"},
+	"SHA2_384": {peerConfigStrongSwan: "sha384", peerConfigLibreswan: "sha2_384", peerConfigCiscoIOS: "sha384", peerConfigAWS: "SHA2-384"},
+	"SHA2_512": {peerConfigStrongSwan: "sha512", peerConfigLibreswan: "sha2_512", peerConfigCiscoIOS: "sha512", peerConfigAWS: "SHA2-512"},
+	// Diffie-Hellman groups.
+	"G_1":  {peerConfigStrongSwan: "modp768", peerConfigLibreswan: "modp768", peerConfigCiscoIOS: "1"},
+	"G_2":  {peerConfigStrongSwan: "modp1024", peerConfigLibreswan: "modp1024", peerConfigCiscoIOS: "2", peerConfigAWS: "2"},
+	"G_5":  {peerConfigStrongSwan: "modp1536", peerConfigLibreswan: "modp1536", peerConfigCiscoIOS: "5"},
+	"G_14": {peerConfigStrongSwan: "modp2048", peerConfigLibreswan: "modp2048", peerConfigCiscoIOS: "14", peerConfigAWS: "14"},
+	"G_15": {peerConfigStrongSwan: "modp3072", peerConfigLibreswan: "modp3072", peerConfigCiscoIOS: "15", peerConfigAWS: "15"},
+	"G_16": {peerConfigStrongSwan: "modp4096", peerConfigLibreswan: "modp4096", peerConfigCiscoIOS: "16", peerConfigAWS: "16"},
+	"G_19": {peerConfigStrongSwan: "ecp256", peerConfigLibreswan: "dh19", peerConfigCiscoIOS: "19", peerConfigAWS: "19"},
+	"G_20": {peerConfigStrongSwan: "ecp384", peerConfigLibreswan: "dh20", peerConfigCiscoIOS: "20", peerConfigAWS: "20"},
+	"G_24": {peerConfigStrongSwan: "modp2048s256", peerConfigCiscoIOS: "24", peerConfigAWS: "24"},
+}
+
+// ciscoESP and ciscoISAKMP map the algorithms of ipSecConfigSchema to their
+// names in Cisco IOS transform sets and IKEv1 ISAKMP policies, which differ
+// from the IKEv2 proposal names in ipsecAlgorithms. ISAKMP has no GCM.
+var (
+	ciscoESP = map[string]string{
+		"AES128": "esp-aes 128", "AES256": "esp-aes 256", "AES128_GCM_16": "esp-gcm 128", "AES256_GCM_16": "esp-gcm 256",
+		"SHA1": "esp-sha-hmac", "SHA2_256": "esp-sha256-hmac", "SHA2_384": "esp-sha384-hmac", "SHA2_512": "esp-sha512-hmac",
+	}
+	ciscoISAKMP = map[string]string{
+		"AES128": "aes 128", "AES256": "aes 256",
+		"SHA1": "sha", "SHA2_256": "sha256", "SHA2_384": "sha384", "SHA2_512": "sha512",
+	}
+)
+
+//go:embed ipsec_peer
+var ipsecPeerFS embed.FS
+
+// ipsecPeerTemplates are the templates of every `remote_peer_config` entry
+// except `aws`, which is rendered as JSON.
+var ipsecPeerTemplates = template.Must(template.New("ipsec_peer").Funcs(template.FuncMap{
+	"join":          strings.Join,
+	"add":           func(a, b int) int { return a + b },
+	"sub":           func(a, b int) int { return a - b },
+	"strongswanIKE": func(p ipsecPhase) string { return strongSwanProposals(p, true) },
+	"strongswanESP": func(p ipsecPhase) string { return strongSwanProposals(p, false) },
+	"libreswanIKE":  func(p ipsecPhase, ikev2 bool) string { return libreswanProposals(p, true, ikev2) },
+	"libreswanESP":  func(p ipsecPhase, ikev2 bool) string { return libreswanProposals(p, false, ikev2) },
+}).ParseFS(ipsecPeerFS, "ipsec_peer/*.tmpl"))
+
+// ipsecPhase holds the algorithms of one IKE phase, translated for a
+// platform.
+type ipsecPhase struct {
+	// Encryption and AEAD split the encryption algorithms into classic
+	// ciphers and GCM ciphers.
+	Encryption []string
+	AEAD       []string
+	Integrity  []string
+	Groups     []string
+	Lifetime   int
+}
+
+// ipsecPeerView is the data the peer templates are rendered with.
+type ipsecPeerView struct {
+	Name         string
+	ConnectorID  string
+	ServerIP     string
+	RemoteSiteIP string
+	IKEv2        bool
+	Certificate  bool
+	PreSharedKey string
+	Phase1       ipsecPhase
+	Phase2       ipsecPhase
+	Margin       int
+	Fuzz         int
+	// RandTime is the strongSwan rekey jitter: Fuzz percent of Margin.
+	RandTime      int
+	ReplayWindow  int
+	DPDTimeout    int
+	DPDRestart    bool
+	PeerInitiates bool
+	// TransformSets and ISAKMPPolicies are the Cisco IOS transform sets and
+	// IKEv1 policies, one per algorithm combination.
+	TransformSets  []string
+	ISAKMPPolicies []ciscoISAKMPPolicy
+	// Unsupported lists the configured values left out for the platform.
+	Unsupported []string
+}
+
+// ciscoISAKMPPolicy is a Cisco IOS `crypto isakmp policy`.
+type ciscoISAKMPPolicy struct {
+	Encryption string
+	Hash       string
+	Group      string
+}
+
+// translatePhase translates the algorithms of p for platform, recording the
+// values the platform does not support in unsupported.
+func translatePhase(p cloudconnexa.Phase, platform string, unsupported *[]string) ipsecPhase {
+	translate := func(values []string) []string {
+		var out []string
+		for _, v := range values {
+			name, ok := ipsecAlgorithms[v][platform]
+			if !ok {
+				if !slices.Contains(*unsupported, v) {
+					*unsupported = append(*unsupported, v)
+				}
+				continue
+			}
+			out = append(out, name)
+		}
+		return out
+	}
+	var classic, aead []string
+	for _, e := range p.EncryptionAlgorithms {
+		if strings.Contains(e, "GCM") {
+			aead = append(aead, e)
+		} else {
+			classic = append(classic, e)
+		}
+	}
+	return ipsecPhase{
+		Encryption: translate(classic),
+		AEAD:       translate(aead),
+		Integrity:  translate(p.IntegrityAlgorithms),
+		Groups:     translate(p.DiffieHellmanGroups),
+		Lifetime:   p.LifetimeSec,
+	}
+}
+
+// newIPsecPeerView builds the template data of connector for platform.
+// preSharedKey replaces the key returned by the API.
+func newIPsecPeerView(connector *cloudconnexa.NetworkConnector, platform, preSharedKey string) ipsecPeerView {
+	cfg := connector.IPSecConfig
+	ike := cfg.IkeProtocol
+	v := ipsecPeerView{
+		Name:          bootstrapName(connector.Name),
+		ConnectorID:   connector.ID,
+		ServerIP:      cfg.ServerIP,
+		RemoteSiteIP:  cfg.RemoteSitePublicIP,
+		IKEv2:         ike.ProtocolVersion == "IKE_V2",
+		Certificate:   cfg.AuthenticationType == "CERTIFICATE",
+		PreSharedKey:  preSharedKey,
+		Margin:        ike.Rekey.MarginTimeSec,
+		Fuzz:          ike.Rekey.FuzzPercent,
+		RandTime:      ike.Rekey.MarginTimeSec * ike.Rekey.FuzzPercent / 100,
+		ReplayWindow:  ike.Rekey.ReplayWindowSize,
+		DPDTimeout:    ike.DeadPeerDetection.TimeoutSec,
+		DPDRestart:    ike.DeadPeerDetection.DeadPeerHandling == "RESTART",
+		PeerInitiates: ike.StartupAction != "START",
+	}
+	if v.ServerIP == "" {
+		v.ServerIP = peerConfigServerIPPlaceholder
+	}
+	if v.PreSharedKey == "" {
+		v.PreSharedKey = peerConfigPSKPlaceholder
+	}
+	v.Phase1 = translatePhase(ike.Phase1, platform, &v.Unsupported)
+	v.Phase2 = translatePhase(ike.Phase2, platform, &v.Unsupported)
+	if platform == peerConfigCiscoIOS {
+		v.TransformSets = ciscoTransformSets(ike.Phase2)
+		if !v.IKEv2 {
+			v.ISAKMPPolicies = ciscoISAKMPPolicies(ike.Phase1, &v.Unsupported)
+		}
+	}
+	return v
+}
+
+// ciscoTransformSets returns the transform of every combination of the
+// phase 2 encryption and integrity algorithms of p. GCM transforms include
+// their integrity check.
+func ciscoTransformSets(p cloudconnexa.Phase) []string {
+	var sets []string
+	for _, e := range p.EncryptionAlgorithms {
+		if strings.Contains(e, "GCM") {
+			sets = append(sets, ciscoESP[e])
+			continue
+		}
+		for _, i := range p.IntegrityAlgorithms {
+			sets = append(sets, ciscoESP[e]+" "+ciscoESP[i])
+		}
+	}
+	return sets
+}
+
+// ciscoISAKMPPolicies returns an IKEv1 policy for every combination of the
+// phase 1 algorithms and groups of p, recording GCM ciphers, which ISAKMP
+// lacks, in unsupported.
+func ciscoISAKMPPolicies(p cloudconnexa.Phase, unsupported *[]string) []ciscoISAKMPPolicy {
+	var policies []ciscoISAKMPPolicy
+	for _, e := range p.EncryptionAlgorithms {
+		encryption, ok := ciscoISAKMP[e]
+		if !ok {
+			if !slices.Contains(*unsupported, e) {
+				*unsupported = append(*unsupported, e)
+			}
+			continue
+		}
+		for _, i := range p.IntegrityAlgorithms {
+			for _, g := range p.DiffieHellmanGroups {
+				if group, ok := ipsecAlgorithms[g][peerConfigCiscoIOS]; ok {
+					policies = append(policies, ciscoISAKMPPolicy{Encryption: encryption, Hash: ciscoISAKMP[i], Group: group})
+				}
+			}
+		}
+	}
+	return policies
+}
+
+// strongSwanProposals returns the swanctl proposals of p: one with the
+// classic ciphers and one with the AEAD ciphers, each listing every
+// algorithm so the mapping stays one-to-one. IKE proposals pair AEAD
+// ciphers with the integrity algorithms as PRFs; ESP ones drop them. The
+// groups of ESP proposals are the PFS groups.
+func strongSwanProposals(p ipsecPhase, ike bool) string {
+	var proposals []string
+	if len(p.Encryption) > 0 {
+		proposals = append(proposals, strings.Join(slices.Concat(p.Encryption, p.Integrity, p.Groups), "-"))
+	}
+	if len(p.AEAD) > 0 {
+		parts := slices.Clone(p.AEAD)
+		if ike {
+			for _, i := range p.Integrity {
+				parts = append(parts, "prf"+i)
+			}
+		}
+		proposals = append(proposals, strings.Join(append(parts, p.Groups...), "-"))
+	}
+	return strings.Join(proposals, ",")
+}
+
+// libreswanProposals returns the libreswan `ike` or `esp` value of p. IKEv2
+// lists alternatives with `+` in a single proposal; IKEv1 needs one
+// proposal per combination. AEAD ciphers use the integrity algorithms as
+// PRFs in IKE and `null` integrity in ESP.
+func libreswanProposals(p ipsecPhase, ike, ikev2 bool) string {
+	aeadIntegrity := []string{"null"}
+	if ike {
+		aeadIntegrity = p.Integrity
+	}
+	groups := p.Groups
+	if len(groups) == 0 {
+		groups = []string{""}
+	}
+	var proposals []string
+	add := func(encryption, integrity []string) {
+		if len(encryption) == 0 {
+			return
+		}
+		if ikev2 {
+			proposals = append(proposals, strings.TrimSuffix(strings.Join(encryption, "+")+"-"+strings.Join(integrity, "+")+"-"+strings.Join(p.Groups, "+"), "-"))
+			return
+		}
+		for _, e := range encryption {
+			for _, i := range integrity {
+				for _, g := range groups {
+					proposals = append(proposals, strings.TrimSuffix(e+"-"+i+"-"+g, "-"))
+				}
+			}
+		}
+	}
+	add(p.Encryption, p.Integrity)
+	add(p.AEAD, aeadIntegrity)
+	return strings.Join(proposals, ",")
+}
+
+// renderRemotePeerConfig renders the configuration of the remote end of the
+// IPsec tunnel of connector for every supported platform.
+//
+// Parameters:
+//   - connector: The network connector as returned by the API
+//   - preSharedKey: The pre-shared key to render; empty renders a placeholder
+//
+// Returns:
+//   - map[string]string: The configurations by `remote_peer_config` key, empty if connector has no IPsec configuration
+//   - error: An error if a template failed to render
+func renderRemotePeerConfig(connector *cloudconnexa.NetworkConnector, preSharedKey string) (map[string]string, error) {
+	if connector.IPSecConfig == nil {
+		return map[string]string{}, nil
+	}
+	out := map[string]string{}
+	for _, entry := range [][2]string{
+		{peerConfigStrongSwan, "strongswan.conf.tmpl"},
+		{peerConfigLibreswan, "libreswan.conf.tmpl"},
+		{peerConfigLibreswanSecrets, "libreswan.secrets.tmpl"},
+		{peerConfigCiscoIOS, "cisco_ios.tmpl"},
+	} {
+		platform := strings.TrimSuffix(entry[0], "_secrets")
+		var buf bytes.Buffer
+		if err := ipsecPeerTemplates.ExecuteTemplate(&buf, entry[1], newIPsecPeerView(connector, platform, preSharedKey)); err != nil {
+			return nil, fmt.Errorf("rendering %s configuration: %w", entry[0], err)
+		}
+		out[entry[0]] = buf.String()
+	}
+	aws, err := renderAWSPeerConfig(newIPsecPeerView(connector, peerConfigAWS, preSharedKey))
+	if err != nil {
+		return nil, err
+	}
+	out[peerConfigAWS] = aws
+	return out, nil
+}
+
+// renderAWSPeerConfig renders v as the tunnel options of an
+// `aws_vpn_connection`, with the CloudConnexa server as customer gateway.
+// The `tunnel_` keys correspond to the `tunnel1_` or `tunnel2_` arguments of
+// the tunnel whose outside address is the remote site public IP.
+func renderAWSPeerConfig(v ipsecPeerView) (string, error) {
+	groups := func(p ipsecPhase) []int {
+		out := make([]int, 0, len(p.Groups))
+		for _, g := range p.Groups {
+			var n int
+			_, _ = fmt.Sscan(g, &n)
+			out = append(out, n)
+		}
+		return out
+	}
+	ikeVersion := "ikev1"
+	if v.IKEv2 {
+		ikeVersion = "ikev2"
+	}
+	dpdAction := "clear"
+	if v.DPDRestart {
+		dpdAction = "restart"
+	}
+	startupAction := "add"
+	if v.PeerInitiates {
+		startupAction = "start"
+	}
+	options := map[string]interface{}{
+		"customer_gateway_ip_address":         v.ServerIP,
+		"tunnel_ike_versions":                 []string{ikeVersion},
+		"tunnel_phase1_encryption_algorithms": append(slices.Clone(v.Phase1.Encryption), v.Phase1.AEAD...),
+		"tunnel_phase1_integrity_algorithms":  v.Phase1.Integrity,
+		"tunnel_phase1_dh_group_numbers":      groups(v.Phase1),
+		"tunnel_phase1_lifetime_seconds":      v.Phase1.Lifetime,
+		"tunnel_phase2_encryption_algorithms": append(slices.Clone(v.Phase2.Encryption), v.Phase2.AEAD...),
+		"tunnel_phase2_integrity_algorithms":  v.Phase2.Integrity,
+		"tunnel_phase2_dh_group_numbers":      groups(v.Phase2),
+		"tunnel_phase2_lifetime_seconds":      v.Phase2.Lifetime,
+		"tunnel_rekey_margin_time_seconds":    v.Margin,
+		"tunnel_rekey_fuzz_percentage":        v.Fuzz,
+		"tunnel_replay_window_size":           v.ReplayWindow,
+		"tunnel_dpd_timeout_seconds":          v.DPDTimeout,
+		"tunnel_dpd_timeout_action":           dpdAction,
+		"tunnel_startup_action":               startupAction,
+		"tunnel_preshared_key":                v.PreSharedKey,
+		"unsupported_values":                  v.Unsupported,
+	}
+	if v.Certificate {
+		delete(options, "tunnel_preshared_key")
+	}
+	if len(v.Unsupported) == 0 {
+		delete(options, "unsupported_values")
+	}
+	b, err := json.MarshalIndent(options, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b) + "\n", nil
+}
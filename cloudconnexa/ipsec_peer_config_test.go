@@ -0,0 +1,209 @@
+package cloudconnexa
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// goldenIPsecConnector returns the fixed connector the IPsec golden files
+// are rendered from, with the given IKE version and algorithms.
+func goldenIPsecConnector(version string, encryption, integrity, groups []string) *cloudconnexa.NetworkConnector {
+	phase := func(lifetime int) cloudconnexa.Phase {
+		return cloudconnexa.Phase{
+			EncryptionAlgorithms: encryption,
+			IntegrityAlgorithms:  integrity,
+			DiffieHellmanGroups:  groups,
+			LifetimeSec:          lifetime,
+		}
+	}
+	return &cloudconnexa.NetworkConnector{
+		ID:   "00000000-0000-4000-8000-000000000002",
+		Name: "AWS VPC",
+		IPSecConfig: &cloudconnexa.IPSecConfig{
+			Platform:           "AWS",
+			AuthenticationType: "SHARED_SECRET",
+			RemoteSitePublicIP: "203.0.113.10",
+			PreSharedKey:       "api-psk",
+			ServerIP:           "198.51.100.7",
+			IkeProtocol: cloudconnexa.IkeProtocol{
+				ProtocolVersion: version,
+				Phase1:          phase(28800),
+				Phase2:          phase(3600),
+				Rekey:           cloudconnexa.Rekey{MarginTimeSec: 540, FuzzPercent: 100, ReplayWindowSize: 1024},
+				DeadPeerDetection: cloudconnexa.DeadPeerDetection{
+					TimeoutSec:       30,
+					DeadPeerHandling: "RESTART",
+				},
+				StartupAction: "START",
+			},
+		},
+	}
+}
+
+func TestUnitIPsecPeerConfigGolden(t *testing.T) {
+	for name, connector := range map[string]*cloudconnexa.NetworkConnector{
+		"ikev2": goldenIPsecConnector("IKE_V2", []string{"AES256", "AES256_GCM_16"}, []string{"SHA2_256", "SHA2_512"}, []string{"G_14", "G_20"}),
+		"ikev1": goldenIPsecConnector("IKE_V1", []string{"AES128", "AES256"}, []string{"SHA1", "SHA2_256"}, []string{"G_2", "G_14"}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			config, err := renderRemotePeerConfig(connector, "my-psk")
+			require.NoError(t, err)
+			assert.Len(t, config, 5)
+			for key, got := range config {
+				path := filepath.Join("testdata", "ipsec_peer", name, key+".golden")
+				if *updateGolden {
+					require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+					require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+				}
+				want, err := os.ReadFile(path)
+				require.NoError(t, err, "run with -update to create the golden file")
+				assert.Equal(t, string(want), got, key)
+			}
+		})
+	}
+}
+
+// ipSecAlgorithmValues returns the values accepted by the list attribute
+// name of ipSecConfigSchema.
+func ipSecAlgorithmValues(t *testing.T, name string) []string {
+	// StringInSlice hides its values, so probe it with every known one and
+	// check that nothing else is accepted.
+	validate := ipSecConfigSchema().Schema[name].Elem.(*schema.Schema).ValidateFunc
+	var values []string
+	for v := range ipsecAlgorithms {
+		if _, errs := validate(v, name); len(errs) == 0 {
+			values = append(values, v)
+		}
+	}
+	_, errs := validate("UNKNOWN", name)
+	require.NotEmpty(t, errs)
+	return values
+}
+
+func TestUnitIPsecPeerConfigAlgorithmsMapOneToOne(t *testing.T) {
+	platforms := []string{peerConfigStrongSwan, peerConfigLibreswan, peerConfigCiscoIOS, peerConfigAWS}
+	for _, name := range []string{
+		"phase_1_encryption_algorithms", "phase_1_integrity_algorithms", "phase_1_diffie_hellman_groups",
+		"phase_2_encryption_algorithms", "phase_2_integrity_algorithms", "phase_2_diffie_hellman_groups",
+	} {
+		values := ipSecAlgorithmValues(t, name)
+		assert.NotEmpty(t, values, name)
+		for _, platform := range platforms {
+			seen := map[string]string{}
+			for _, v := range values {
+				translated, ok := ipsecAlgorithms[v][platform]
+				if !ok {
+					continue
+				}
+				assert.NotContains(t, seen, translated, "%s: %s and %s both map to %q", platform, seen[translated], v, translated)
+				seen[translated] = v
+			}
+		}
+	}
+	for v := range ipsecAlgorithms {
+		if strings.HasPrefix(v, "G_") {
+			continue
+		}
+		assert.Contains(t, ciscoESP, v)
+	}
+}
+
+func TestUnitIPsecPeerConfigUnsupported(t *testing.T) {
+	connector := goldenIPsecConnector("IKE_V1", []string{"AES128", "AES128_GCM_16"}, []string{"SHA1"}, []string{"G_1", "G_24"})
+	connector.IPSecConfig.ServerIP = ""
+	connector.IPSecConfig.AuthenticationType = "CERTIFICATE"
+	config, err := renderRemotePeerConfig(connector, "")
+	require.NoError(t, err)
+
+	assert.Contains(t, config[peerConfigLibreswan], "# WARNING: G_24 is not supported by libreswan and was left out.")
+	assert.Contains(t, config[peerConfigLibreswan], "right="+peerConfigServerIPPlaceholder)
+	assert.Contains(t, config[peerConfigLibreswan], "authby=rsasig")
+	assert.NotContains(t, config[peerConfigStrongSwan], "WARNING")
+	assert.NotContains(t, config[peerConfigStrongSwan], "secrets {")
+	assert.Contains(t, config[peerConfigCiscoIOS], "! WARNING: AES128_GCM_16 is not supported by Cisco IOS and was left out.")
+	assert.NotContains(t, config[peerConfigCiscoIOS], "crypto isakmp key")
+
+	var aws map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(config[peerConfigAWS]), &aws))
+	assert.Equal(t, []interface{}{"G_1"}, aws["unsupported_values"])
+	assert.Equal(t, []interface{}{float64(24)}, aws["tunnel_phase1_dh_group_numbers"])
+	assert.NotContains(t, aws, "tunnel_preshared_key")
+
+	config, err = renderRemotePeerConfig(&cloudconnexa.NetworkConnector{ID: "x"}, "")
+	require.NoError(t, err)
+	assert.Empty(t, config)
+}
+
+func TestUnitFakeAPIRemotePeerConfig(t *testing.T) {
+	_, p := newFakeAPIProvider(t, fakeapi.Options{})
+	ctx := context.Background()
+	c := clientFromMeta(ctx, p.Meta())
+	network, err := c.Networks.Create(cloudconnexa.Network{Name: "vpc", InternetAccess: "SPLIT_TUNNEL_ON", Routes: []cloudconnexa.Route{{Subnet: "10.0.0.0/16", Type: "IP_V4"}}})
+	require.NoError(t, err)
+
+	r := p.ResourcesMap["cloudconnexa_network_connector"]
+	ipsecConfig := map[string]interface{}{
+		"platform":                      "AWS",
+		"authentication_type":           "SHARED_SECRET",
+		"remote_site_public_ip":         "203.0.113.10",
+		"pre_shared_key":                "secret-psk",
+		"protocol_version":              "IKE_V2",
+		"phase_1_encryption_algorithms": []interface{}{"AES256"},
+		"phase_1_integrity_algorithms":  []interface{}{"SHA2_256"},
+		"phase_1_diffie_hellman_groups": []interface{}{"G_14"},
+		"phase_1_lifetime_sec":          28800,
+		"phase_2_encryption_algorithms": []interface{}{"AES256"},
+		"phase_2_integrity_algorithms":  []interface{}{"SHA2_256"},
+		"phase_2_diffie_hellman_groups": []interface{}{"G_14"},
+		"phase_2_lifetime_sec":          3600,
+		"margin_time_sec":               540,
+		"fuzz_percent":                  100,
+		"replay_window_size":            1024,
+		"timeout_sec":                   30,
+		"dead_peer_handling":            "RESTART",
+		"startup_action":                "START",
+	}
+	raw := map[string]interface{}{
+		"name":          "VPC GW",
+		"vpn_region_id": "us-east-1",
+		"network_id":    network.ID,
+		"ipsec_config":  []interface{}{ipsecConfig},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	diags := r.CreateContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+	config := d.Get("remote_peer_config").(map[string]interface{})
+	assert.Len(t, config, 5)
+	assert.Contains(t, config[peerConfigLibreswanSecrets], `203.0.113.10 198.51.100.`)
+	assert.Contains(t, config[peerConfigLibreswanSecrets], `PSK "secret-psk"`)
+
+	diags = r.ReadContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, d.Get("remote_peer_config.strongswan"), "proposals = aes256-sha256-modp2048")
+
+	// Only changes to ipsec_config or the name change the peer configuration.
+	raw["description"] = "edited"
+	diff, err := r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(raw), p.Meta())
+	require.NoError(t, err)
+	assert.NotContains(t, diff.Attributes, "remote_peer_config.%")
+
+	ipsecConfig["remote_site_public_ip"] = "203.0.113.20"
+	diff, err = r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(raw), p.Meta())
+	require.NoError(t, err)
+	require.Contains(t, diff.Attributes, "remote_peer_config.%")
+	assert.True(t, diff.Attributes["remote_peer_config.%"].NewComputed)
+	state, diags := r.Apply(ctx, d.State(), diff, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, state.Attributes["remote_peer_config.libreswan_secrets"], `203.0.113.20 198.51.100.`)
+}
@@ -0,0 +1,223 @@
+package cloudconnexa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ipSecPreset holds the IKE settings of an `ipsec_config` platform, applied
+// to the settings left unset in the configuration. Both phases use the same
+// algorithms.
+type ipSecPreset struct {
+	ProtocolVersion  string
+	Encryption       []string
+	Integrity        []string
+	Groups           []string
+	Phase1Lifetime   int
+	Phase2Lifetime   int
+	Margin           int
+	Fuzz             int
+	ReplayWindow     int
+	DPDTimeout       int
+	DeadPeerHandling string
+	StartupAction    string
+}
+
+// ipSecPresets holds the preset of every `platform`, matching the defaults of
+// the cloud VPN gateways: AWS Site-to-Site VPN, Azure VPN Gateway, GCP Cloud
+// VPN and Cisco IOS. OTHER gets settings most IKEv2 peers accept.
+var ipSecPresets = map[string]ipSecPreset{
+	"AWS":   newIPsecPreset(28800, 3600, 30),
+	"AZURE": newIPsecPreset(28800, 27000, 45),
+	"GCP":   newIPsecPreset(36000, 10800, 30),
+	"CISCO": newIPsecPreset(86400, 3600, 30),
+	"OTHER": newIPsecPreset(28800, 3600, 30),
+}
+
+// newIPsecPreset returns an IKEv2 preset with AES-256, SHA-256 and DH group
+// 14 and the given lifetimes and dead peer detection timeout.
+func newIPsecPreset(phase1Lifetime, phase2Lifetime, dpdTimeout int) ipSecPreset {
+	return ipSecPreset{
+		ProtocolVersion:  "IKE_V2",
+		Encryption:       []string{"AES256"},
+		Integrity:        []string{"SHA2_256"},
+		Groups:           []string{"G_14"},
+		Phase1Lifetime:   phase1Lifetime,
+		Phase2Lifetime:   phase2Lifetime,
+		Margin:           540,
+		Fuzz:             100,
+		ReplayWindow:     1024,
+		DPDTimeout:       dpdTimeout,
+		DeadPeerHandling: "RESTART",
+		StartupAction:    "START",
+	}
+}
+
+// values returns the preset by `ipsec_config` attribute, in the form d.Get
+// returns them.
+func (p ipSecPreset) values() map[string]interface{} {
+	list := func(values []string) []interface{} {
+		out := make([]interface{}, len(values))
+		for i, v := range values {
+			out[i] = v
+		}
+		return out
+	}
+	return map[string]interface{}{
+		"protocol_version":              p.ProtocolVersion,
+		"phase_1_encryption_algorithms": list(p.Encryption),
+		"phase_1_integrity_algorithms":  list(p.Integrity),
+		"phase_1_diffie_hellman_groups": list(p.Groups),
+		"phase_1_lifetime_sec":          p.Phase1Lifetime,
+		"phase_2_encryption_algorithms": list(p.Encryption),
+		"phase_2_integrity_algorithms":  list(p.Integrity),
+		"phase_2_diffie_hellman_groups": list(p.Groups),
+		"phase_2_lifetime_sec":          p.Phase2Lifetime,
+		"margin_time_sec":               p.Margin,
+		"fuzz_percent":                  p.Fuzz,
+		"replay_window_size":            p.ReplayWindow,
+		"timeout_sec":                   p.DPDTimeout,
+		"dead_peer_handling":            p.DeadPeerHandling,
+		"startup_action":                p.StartupAction,
+	}
+}
+
+// ipSecPresetAttributes lists the `ipsec_config` attributes that default to
+// the preset of the platform.
+var ipSecPresetAttributes = func() []string {
+	var names []string
+	for name := range ipSecPresets["OTHER"].values() {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}()
+
+// ipSecConfigAttr returns the raw configuration of the `ipsec_config`
+// attribute name: unknown if the block is not known yet, null if it is
+// unset or the raw configuration is not available.
+func ipSecConfigAttr(raw cty.Value, name string) cty.Value {
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute("ipsec_config") {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	list := raw.GetAttr("ipsec_config")
+	if !list.IsKnown() {
+		return cty.DynamicVal
+	}
+	if list.IsNull() || list.LengthInt() == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	block := list.Index(cty.NumberIntVal(0))
+	if !block.IsKnown() {
+		return cty.DynamicVal
+	}
+	return block.GetAttr(name)
+}
+
+// applyIPsecPreset fills the attributes of the `ipsec_config` block cfg that
+// are unset in the raw configuration and have no value yet from the preset
+// of its platform. Values kept in state are left alone, so changing the
+// platform of an existing block only changes the attributes it sets.
+func applyIPsecPreset(raw cty.Value, cfg map[string]interface{}) {
+	preset, ok := ipSecPresets[cfg["platform"].(string)]
+	if !ok {
+		return
+	}
+	for name, value := range preset.values() {
+		if ipSecConfigAttr(raw, name).IsNull() && isEmptyIPsecValue(cfg[name]) {
+			cfg[name] = value
+		}
+	}
+}
+
+// isEmptyIPsecValue reports whether v, as returned by d.Get for an
+// `ipsec_config` attribute, holds no value.
+func isEmptyIPsecValue(v interface{}) bool {
+	switch v := v.(type) {
+	case []interface{}:
+		return len(v) == 0
+	case nil:
+		return true
+	default:
+		return v == "" || v == 0
+	}
+}
+
+// validateIPsecConfig rejects `ipsec_config` combinations the tunnel cannot
+// be set up with, after applying the platform preset. Values not known
+// until apply are not checked.
+//
+// Parameters:
+//   - ctx: The context for the operation
+//   - diff: The Terraform resource diff
+//   - m: The provider meta interface
+//
+// Returns:
+//   - error: An error listing every inconsistency found
+func validateIPsecConfig(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	configs := diff.Get("ipsec_config").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+	cfg := configs[0].(map[string]interface{})
+	raw := diff.GetRawConfig()
+	applyIPsecPreset(raw, cfg)
+
+	var errs []error
+	if cfg["protocol_version"] == "IKE_V1" {
+		for _, algorithm := range toStrings(cfg["phase_1_encryption_algorithms"].([]interface{})) {
+			if strings.Contains(algorithm, "GCM") {
+				errs = append(errs, fmt.Errorf("ipsec_config.0.phase_1_encryption_algorithms: %s requires protocol_version IKE_V2, IKE_V1 has no GCM in phase 1", algorithm))
+			}
+		}
+	}
+
+	phase1, phase2 := cfg["phase_1_lifetime_sec"].(int), cfg["phase_2_lifetime_sec"].(int)
+	margin, fuzz := cfg["margin_time_sec"].(int), cfg["fuzz_percent"].(int)
+	if phase1 > 0 && phase2 > phase1 {
+		errs = append(errs, fmt.Errorf("ipsec_config.0.phase_2_lifetime_sec (%d) must not exceed phase_1_lifetime_sec (%d)", phase2, phase1))
+	}
+	if fuzz < 0 || fuzz > 100 {
+		errs = append(errs, fmt.Errorf("ipsec_config.0.fuzz_percent (%d) must be between 0 and 100", fuzz))
+	} else if phase2 > 0 && margin > 0 && margin+margin*fuzz/100 >= phase2 {
+		errs = append(errs, fmt.Errorf("ipsec_config.0.margin_time_sec (%d) plus fuzz_percent (%d%%) of it must be shorter than phase_2_lifetime_sec (%d)", margin, fuzz, phase2))
+	}
+
+	// isSet reports whether one of the given attributes is set, and false
+	// for known if any of them is not known yet.
+	isSet := func(names ...string) (set, known bool) {
+		known = true
+		for _, name := range names {
+			v := ipSecConfigAttr(raw, name)
+			if !v.IsKnown() {
+				known = false
+				continue
+			}
+			if !v.IsNull() && !v.RawEquals(cty.StringVal("")) {
+				set = true
+			}
+		}
+		return set, known
+	}
+	var required [][]string
+	switch cfg["authentication_type"] {
+	case "SHARED_SECRET":
+		required = [][]string{{"pre_shared_key", "pre_shared_key_wo"}}
+	case "CERTIFICATE":
+		required = [][]string{{"ca_certificate"}, {"peer_certificate"}, {"peer_certificate_private_key", "peer_certificate_private_key_wo"}}
+	}
+	if !raw.IsNull() {
+		for _, names := range required {
+			if set, known := isSet(names...); known && !set {
+				errs = append(errs, fmt.Errorf("ipsec_config.0.%s must be set when authentication_type is %s", strings.Join(names, " or "), cfg["authentication_type"]))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,206 @@
+package cloudconnexa
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// networkConnectorRawConfig returns the raw configuration of a network
+// connector with an `ipsec_config` block holding attrs.
+func networkConnectorRawConfig(r *schema.Resource, attrs map[string]cty.Value) cty.Value {
+	ty := r.CoreConfigSchema().ImpliedType()
+	return objectWithNulls(ty, map[string]cty.Value{
+		"name":          cty.StringVal("vpc"),
+		"vpn_region_id": cty.StringVal("us-east-1"),
+		"network_id":    cty.StringVal("network-id"),
+		"ipsec_config": cty.ListVal([]cty.Value{
+			objectWithNulls(ty.AttributeType("ipsec_config").ElementType(), attrs),
+		}),
+	})
+}
+
+// planNetworkConnector plans the creation of a network connector with the
+// given raw configuration.
+func planNetworkConnector(r *schema.Resource, raw cty.Value) error {
+	_, err := r.Diff(context.Background(), &terraform.InstanceState{RawConfig: raw}, terraform.NewResourceConfigShimmed(raw, r.CoreConfigSchema()), nil)
+	return err
+}
+
+func TestUnitApplyIPsecPreset(t *testing.T) {
+	r := resourceNetworkConnector()
+	raw := networkConnectorRawConfig(r, map[string]cty.Value{
+		"platform":     cty.StringVal("AZURE"),
+		"fuzz_percent": cty.NumberIntVal(0),
+	})
+	cfg := map[string]interface{}{
+		"platform":                      "AZURE",
+		"phase_1_encryption_algorithms": []interface{}{},
+		"phase_2_lifetime_sec":          0,
+		"fuzz_percent":                  0,
+		"timeout_sec":                   60,
+	}
+	applyIPsecPreset(raw, cfg)
+	assert.Equal(t, []interface{}{"AES256"}, cfg["phase_1_encryption_algorithms"])
+	assert.Equal(t, 27000, cfg["phase_2_lifetime_sec"])
+	assert.Equal(t, "IKE_V2", cfg["protocol_version"])
+	assert.Equal(t, 0, cfg["fuzz_percent"], "explicitly configured")
+	assert.Equal(t, 60, cfg["timeout_sec"], "kept from state")
+
+	for platform, preset := range ipSecPresets {
+		assert.Less(t, preset.Phase2Lifetime, preset.Phase1Lifetime+1, platform)
+		assert.Less(t, preset.Margin*(100+preset.Fuzz)/100, preset.Phase2Lifetime, platform)
+	}
+	assert.ElementsMatch(t, ipSecPresetAttributes, []string{
+		"protocol_version", "startup_action", "margin_time_sec", "fuzz_percent", "replay_window_size", "timeout_sec", "dead_peer_handling",
+		"phase_1_encryption_algorithms", "phase_1_integrity_algorithms", "phase_1_diffie_hellman_groups", "phase_1_lifetime_sec",
+		"phase_2_encryption_algorithms", "phase_2_integrity_algorithms", "phase_2_diffie_hellman_groups", "phase_2_lifetime_sec",
+	})
+}
+
+func TestUnitValidateIPsecConfig(t *testing.T) {
+	r := resourceNetworkConnector()
+	base := func(attrs map[string]cty.Value) map[string]cty.Value {
+		out := map[string]cty.Value{
+			"platform":              cty.StringVal("AWS"),
+			"authentication_type":   cty.StringVal("SHARED_SECRET"),
+			"remote_site_public_ip": cty.StringVal("203.0.113.10"),
+			"pre_shared_key":        cty.StringVal("psk"),
+		}
+		for k, v := range attrs {
+			out[k] = v
+		}
+		return out
+	}
+	for name, tc := range map[string]struct {
+		attrs map[string]cty.Value
+		err   string
+	}{
+		"preset only": {attrs: base(nil)},
+		"write-only key": {attrs: base(map[string]cty.Value{
+			"pre_shared_key":            cty.NullVal(cty.String),
+			"pre_shared_key_wo":         cty.StringVal("psk"),
+			"pre_shared_key_wo_version": cty.NumberIntVal(1),
+		})},
+		"unknown key": {attrs: base(map[string]cty.Value{"pre_shared_key": cty.UnknownVal(cty.String)})},
+		"missing key": {
+			attrs: base(map[string]cty.Value{"pre_shared_key": cty.NullVal(cty.String)}),
+			err:   "ipsec_config.0.pre_shared_key or pre_shared_key_wo must be set when authentication_type is SHARED_SECRET",
+		},
+		"missing certificates": {
+			attrs: base(map[string]cty.Value{
+				"authentication_type": cty.StringVal("CERTIFICATE"),
+				"ca_certificate":      cty.StringVal("ca"),
+			}),
+			err: "ipsec_config.0.peer_certificate must be set when authentication_type is CERTIFICATE",
+		},
+		"ikev1 with gcm": {
+			attrs: base(map[string]cty.Value{
+				"protocol_version":              cty.StringVal("IKE_V1"),
+				"phase_1_encryption_algorithms": cty.ListVal([]cty.Value{cty.StringVal("AES256_GCM_16")}),
+			}),
+			err: "AES256_GCM_16 requires protocol_version IKE_V2",
+		},
+		"ikev1 with gcm in phase 2": {
+			attrs: base(map[string]cty.Value{
+				"protocol_version":              cty.StringVal("IKE_V1"),
+				"phase_2_encryption_algorithms": cty.ListVal([]cty.Value{cty.StringVal("AES256_GCM_16")}),
+			}),
+		},
+		"phase 2 outlives phase 1": {
+			attrs: base(map[string]cty.Value{"phase_2_lifetime_sec": cty.NumberIntVal(30000)}),
+			err:   "phase_2_lifetime_sec (30000) must not exceed phase_1_lifetime_sec (28800)",
+		},
+		"margin too long": {
+			attrs: base(map[string]cty.Value{"margin_time_sec": cty.NumberIntVal(1800)}),
+			err:   "margin_time_sec (1800) plus fuzz_percent (100%) of it must be shorter than phase_2_lifetime_sec (3600)",
+		},
+		"fuzz out of range": {
+			attrs: base(map[string]cty.Value{"fuzz_percent": cty.NumberIntVal(150)}),
+			err:   "fuzz_percent (150) must be between 0 and 100",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := planNetworkConnector(r, networkConnectorRawConfig(r, tc.attrs))
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestUnitFakeAPIIPsecPreset(t *testing.T) {
+	_, p := newFakeAPIProvider(t, fakeapi.Options{})
+	ctx := context.Background()
+	c := clientFromMeta(ctx, p.Meta())
+	network, err := c.Networks.Create(cloudconnexa.Network{Name: "vnet", InternetAccess: "SPLIT_TUNNEL_ON", Routes: []cloudconnexa.Route{{Subnet: "10.1.0.0/16", Type: "IP_V4"}}})
+	require.NoError(t, err)
+
+	r := p.ResourcesMap["cloudconnexa_network_connector"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":          "VNet GW",
+		"vpn_region_id": "us-east-1",
+		"network_id":    network.ID,
+		"ipsec_config": []interface{}{map[string]interface{}{
+			"platform":              "AZURE",
+			"authentication_type":   "SHARED_SECRET",
+			"remote_site_public_ip": "203.0.113.20",
+			"pre_shared_key":        "secret-psk",
+			"timeout_sec":           60,
+		}},
+	})
+	diags := r.CreateContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+
+	connector, err := c.NetworkConnectors.GetByID(d.Id())
+	require.NoError(t, err)
+	ike := connector.IPSecConfig.IkeProtocol
+	assert.Equal(t, "IKE_V2", ike.ProtocolVersion)
+	assert.Equal(t, []string{"AES256"}, ike.Phase2.EncryptionAlgorithms)
+	assert.Equal(t, 27000, ike.Phase2.LifetimeSec)
+	assert.Equal(t, 60, ike.DeadPeerDetection.TimeoutSec)
+
+	diags = r.ReadContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 28800, d.Get("ipsec_config.0.phase_1_lifetime_sec"))
+	assert.Equal(t, "START", d.Get("ipsec_config.0.startup_action"))
+}
+
+// TestUnitFakeAPIIPsecPresetInStateAfterCreate checks that the lifetimes
+// preset by the API for a connector created without them are in state
+// right after Create, without a separate Read.
+func TestUnitFakeAPIIPsecPresetInStateAfterCreate(t *testing.T) {
+	_, p := newFakeAPIProvider(t, fakeapi.Options{})
+	ctx := context.Background()
+	c := clientFromMeta(ctx, p.Meta())
+	network, err := c.Networks.Create(cloudconnexa.Network{Name: "vnet", InternetAccess: "SPLIT_TUNNEL_ON", Routes: []cloudconnexa.Route{{Subnet: "10.1.0.0/16", Type: "IP_V4"}}})
+	require.NoError(t, err)
+
+	r := p.ResourcesMap["cloudconnexa_network_connector"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":          "VNet GW",
+		"vpn_region_id": "us-east-1",
+		"network_id":    network.ID,
+		"ipsec_config": []interface{}{map[string]interface{}{
+			"platform":              "AZURE",
+			"authentication_type":   "SHARED_SECRET",
+			"remote_site_public_ip": "203.0.113.20",
+			"pre_shared_key":        "secret-psk",
+		}},
+	})
+	diags := r.CreateContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, 28800, d.Get("ipsec_config.0.phase_1_lifetime_sec"))
+	assert.Equal(t, 27000, d.Get("ipsec_config.0.phase_2_lifetime_sec"))
+	assert.Equal(t, "START", d.Get("ipsec_config.0.startup_action"))
+}
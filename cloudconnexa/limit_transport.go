@@ -0,0 +1,89 @@
+package cloudconnexa
+
+import (
+	"io"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// limitTransport is an http.RoundTripper that caps the number of in-flight
+// API requests and the overall request rate for one configured provider. It
+// sits below retryTransport so every retry attempt also counts against the
+// budget.
+type limitTransport struct {
+	next http.RoundTripper
+
+	// slots is a counting semaphore; nil means unlimited concurrency.
+	slots chan struct{}
+	// limiter throttles request starts; nil means no rate limit.
+	limiter *rate.Limiter
+}
+
+// newLimitTransport wraps next with a concurrency cap of maxConcurrent
+// requests and a sustained rate of requestsPerSecond. A zero value disables
+// the corresponding limit.
+func newLimitTransport(next http.RoundTripper, maxConcurrent int, requestsPerSecond float64) *limitTransport {
+	t := &limitTransport{next: next}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		burst := max(int(requestsPerSecond), 1)
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper. The concurrency slot is held until
+// the response body is closed, so slow downloads are accounted for too.
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := t.releaseFunc()
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseFunc returns an idempotent function that frees the slot taken by
+// the current request.
+func (t *limitTransport) releaseFunc() func() {
+	if t.slots == nil {
+		return func() {}
+	}
+	var once sync.Once
+	return func() { once.Do(func() { <-t.slots }) }
+}
+
+// releasingBody frees a concurrency slot when the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close implements io.Closer.
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
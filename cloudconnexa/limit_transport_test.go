@@ -0,0 +1,95 @@
+package cloudconnexa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnitLimitTransport_CapsConcurrency fires many parallel requests at a
+// slow server and checks that no more than the configured number are ever in
+// flight at once.
+func TestUnitLimitTransport_CapsConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := &http.Client{Transport: newLimitTransport(http.DefaultTransport, 2, 0)}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if assert.NoError(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+// TestUnitLimitTransport_ReleasesSlotOnError checks that a failed round trip
+// does not leak its concurrency slot.
+func TestUnitLimitTransport_ReleasesSlotOnError(t *testing.T) {
+	transport := newLimitTransport(http.DefaultTransport, 1, 0)
+	client := &http.Client{Transport: transport}
+	for i := 0; i < 3; i++ {
+		_, err := client.Get("http://127.0.0.1:1")
+		require.Error(t, err)
+	}
+	assert.Empty(t, transport.slots)
+}
+
+// TestUnitLimitTransport_RateLimit checks that requests beyond the burst are
+// spaced out according to requests_per_second.
+func TestUnitLimitTransport_RateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := &http.Client{Transport: newLimitTransport(http.DefaultTransport, 0, 20)}
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	// 20 requests fit in the initial burst, the remaining 10 need ~500ms.
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+// TestUnitLimitTransport_WaitHonorsContext checks that a request waiting for
+// a slot gives up when its context is cancelled.
+func TestUnitLimitTransport_WaitHonorsContext(t *testing.T) {
+	transport := newLimitTransport(http.DefaultTransport, 1, 0)
+	transport.slots <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:1", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,330 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// listItem is an object found by a list resource.
+type listItem struct {
+	id          string
+	displayName string
+}
+
+// listResource enumerates existing objects of the managed resource type of
+// the same name. frameworkListResource serves it for `terraform query`, and
+// export and find-unmanaged use it directly.
+type listResource struct {
+	// filter is the optional config attribute holding the ID of the parent
+	// network or host to list the objects of, if the type has one.
+	filter string
+	// list returns the objects of the parent parentID, or of all parents
+	// if it is empty.
+	list func(ctx context.Context, c *cloudconnexa.Client, parentID string) ([]listItem, error)
+	// prepare, if set, adjusts the resource data before it is read to build
+	// the full resource object.
+	prepare func(d *schema.ResourceData)
+}
+
+// listResources returns the provider's list resources by type name. Each
+// lists the objects of the managed resource type of the same name.
+func listResources() map[string]*listResource {
+	lists := map[string]*listResource{
+		"cloudconnexa_access_group": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.AccessGroup, error) {
+			return c.AccessGroups.List()
+		}, func(g cloudconnexa.AccessGroup) listItem { return listItem{g.ID, g.Name} }),
+		"cloudconnexa_dns_record": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.DNSRecord, error) {
+			return c.DNSRecords.List()
+		}, func(r cloudconnexa.DNSRecord) listItem { return listItem{r.ID, r.Domain} }),
+		"cloudconnexa_host": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.Host, error) {
+			return c.Hosts.List()
+		}, func(h cloudconnexa.Host) listItem { return listItem{h.ID, h.Name} }),
+		"cloudconnexa_host_application": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.ApplicationResponse, error) {
+			return c.HostApplications.List()
+		}, func(a cloudconnexa.ApplicationResponse) listItem { return listItem{a.ID, a.Name} }),
+		"cloudconnexa_host_connector": listConnectors("host_id", func(c *cloudconnexa.Client, hostID string) ([]cloudconnexa.HostConnector, error) {
+			return c.HostConnectors.ListByHostID(hostID)
+		}, func(h cloudconnexa.HostConnector) listItem { return listItem{h.ID, h.Name} }),
+		"cloudconnexa_host_ip_service": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.HostIPServiceResponse, error) {
+			return c.HostIPServices.List()
+		}, func(s cloudconnexa.HostIPServiceResponse) listItem { return listItem{s.ID, s.Name} }),
+		"cloudconnexa_location_context": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.LocationContext, error) {
+			return c.LocationContexts.List()
+		}, func(l cloudconnexa.LocationContext) listItem { return listItem{l.ID, l.Name} }),
+		"cloudconnexa_network": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.Network, error) {
+			return c.Networks.List()
+		}, func(n cloudconnexa.Network) listItem { return listItem{n.ID, n.Name} }),
+		"cloudconnexa_network_application": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.NetworkApplicationResponse, error) {
+			return c.NetworkApplications.List()
+		}, func(a cloudconnexa.NetworkApplicationResponse) listItem { return listItem{a.ID, a.Name} }),
+		"cloudconnexa_network_connector": listConnectors("network_id", func(c *cloudconnexa.Client, networkID string) ([]cloudconnexa.NetworkConnector, error) {
+			return c.NetworkConnectors.ListByNetworkID(networkID)
+		}, func(n cloudconnexa.NetworkConnector) listItem { return listItem{n.ID, n.Name} }),
+		"cloudconnexa_network_ip_service": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.NetworkIPServiceResponse, error) {
+			return c.NetworkIPServices.List()
+		}, func(s cloudconnexa.NetworkIPServiceResponse) listItem { return listItem{s.ID, s.Name} }),
+		"cloudconnexa_route":    listRoutes(),
+		"cloudconnexa_settings": listSettings(),
+		"cloudconnexa_user": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.User, error) {
+			return c.Users.List()
+		}, func(u cloudconnexa.User) listItem { return listItem{u.ID, u.Username} }),
+		"cloudconnexa_user_group": listAll(func(c *cloudconnexa.Client) ([]cloudconnexa.UserGroup, error) {
+			return c.UserGroups.List()
+		}, func(g cloudconnexa.UserGroup) listItem { return listItem{g.ID, g.Name} }),
+	}
+	return lists
+}
+
+// toListItems converts API objects to list items.
+func toListItems[T any](objects []T, item func(T) listItem) []listItem {
+	items := make([]listItem, 0, len(objects))
+	for _, o := range objects {
+		items = append(items, item(o))
+	}
+	return items
+}
+
+// listAll returns a list resource without configuration that lists every
+// object returned by list.
+func listAll[T any](list func(c *cloudconnexa.Client) ([]T, error), item func(T) listItem) *listResource {
+	return &listResource{
+		list: func(_ context.Context, c *cloudconnexa.Client, _ string) ([]listItem, error) {
+			objects, err := list(c)
+			if err != nil {
+				return nil, err
+			}
+			return toListItems(objects, item), nil
+		},
+	}
+}
+
+// listConnectors returns a connector list resource filtered by the optional
+// parent ID attribute parentAttribute. Connectors are read with
+// `omit_credentials` set, so listing them does not fetch their token and
+// profile.
+func listConnectors[T any](parentAttribute string, list func(c *cloudconnexa.Client, parentID string) ([]T, error), item func(T) listItem) *listResource {
+	return &listResource{
+		filter: parentAttribute,
+		list: func(_ context.Context, c *cloudconnexa.Client, parentID string) ([]listItem, error) {
+			objects, err := list(c, parentID)
+			if err != nil {
+				return nil, err
+			}
+			return toListItems(objects, item), nil
+		},
+		prepare: func(d *schema.ResourceData) {
+			_ = d.Set("omit_credentials", true)
+		},
+	}
+}
+
+// listSettings returns the settings list resource. The account settings
+// are a single object that always exists, with the ID `settings`.
+func listSettings() *listResource {
+	return &listResource{
+		list: func(context.Context, *cloudconnexa.Client, string) ([]listItem, error) {
+			return []listItem{{"settings", "settings"}}, nil
+		},
+	}
+}
+
+// listRoutes returns the route list resource. Routes belong to a network;
+// without `network_item_id` the routes of all networks are listed.
+func listRoutes() *listResource {
+	return &listResource{
+		filter: "network_item_id",
+		list: func(_ context.Context, c *cloudconnexa.Client, networkID string) ([]listItem, error) {
+			networkIDs := []string{networkID}
+			if networkID == "" {
+				networks, err := c.Networks.List()
+				if err != nil {
+					return nil, err
+				}
+				networkIDs = networkIDs[:0]
+				for _, n := range networks {
+					networkIDs = append(networkIDs, n.ID)
+				}
+			}
+			var items []listItem
+			for _, id := range networkIDs {
+				routes, err := c.Routes.List(id)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, toListItems(routes, func(r cloudconnexa.Route) listItem {
+					name := r.Subnet
+					if name == "" {
+						name = r.Domain
+					}
+					return listItem{r.ID, name}
+				})...)
+			}
+			return items, nil
+		},
+	}
+}
+
+// read reads the object id of resourceType through the resource's own
+// Read function and returns its state as an object of the resource's type:
+// SDKv2 resources of p, configured with the API client, or framework
+// resources given the same client. The value is null if the object no
+// longer exists.
+func (l *listResource) read(ctx context.Context, p *schema.Provider, resourceType, id string) (cty.Value, error) {
+	if newResource, ok := frameworkResources()[resourceType]; ok {
+		return readFrameworkObject(ctx, newResource(), p.Meta(), id)
+	}
+	r, ok := p.ResourcesMap[resourceType]
+	if !ok {
+		return cty.NilVal, fmt.Errorf("unknown resource type %s", resourceType)
+	}
+	ty := r.CoreConfigSchema().ImpliedType()
+	d := r.Data(nil)
+	d.SetId(id)
+	if l.prepare != nil {
+		l.prepare(d)
+	}
+	if diags := r.ReadContext(ctx, d, p.Meta()); diags.HasError() {
+		return cty.NilVal, fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+	}
+	if d.Id() == "" {
+		return cty.NullVal(ty), nil
+	}
+	return d.State().AttrsAsObjectValue(ty)
+}
+
+// frameworkListResource serves the listResource def for `terraform query`.
+// Results carry the resource identity, so Terraform can generate import
+// blocks for them.
+type frameworkListResource struct {
+	typeName string
+	def      *listResource
+	// sdk is the SDKv2 provider, which reads the objects of its resources
+	// and supplies their schemas.
+	sdk    *schema.Provider
+	client *cloudconnexa.Client
+}
+
+var (
+	_ list.ListResourceWithConfigure    = (*frameworkListResource)(nil)
+	_ list.ListResourceWithRawV5Schemas = (*frameworkListResource)(nil)
+)
+
+// Metadata implements list.ListResource.
+func (l *frameworkListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = l.typeName
+}
+
+// ListResourceConfigSchema implements list.ListResource.
+func (l *frameworkListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists the existing `%s` resources of the account, e.g. to import them with `terraform query`.", l.typeName),
+	}
+	if l.def.filter != "" {
+		resp.Schema.Attributes = map[string]listschema.Attribute{
+			l.def.filter: listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list objects of the network or host with this ID.",
+			},
+		}
+	}
+}
+
+// RawV5Schemas implements list.ListResourceWithRawV5Schemas, supplying the
+// schemas of SDKv2 resources. Framework resources have their own.
+func (l *frameworkListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	r, ok := l.sdk.ResourcesMap[l.typeName]
+	if !ok {
+		return
+	}
+	resp.ProtoV5Schema = r.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = r.ProtoIdentitySchema(ctx)()
+}
+
+// Configure implements list.ListResourceWithConfigure.
+func (l *frameworkListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if c := providerDataClient(req.ProviderData, &resp.Diagnostics); c != nil {
+		l.client = c
+	}
+}
+
+// List implements list.ListResource.
+func (l *frameworkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var parentID types.String
+	if l.def.filter != "" {
+		diags := req.Config.GetAttribute(ctx, path.Root(l.def.filter), &parentID)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+	if l.client == nil {
+		stream.Results = listErrorResults("Unable to list "+l.typeName, errProviderNotConfigured)
+		return
+	}
+	items, err := l.def.list(ctx, clientFromMeta(ctx, l.client), parentID.ValueString())
+	if err != nil {
+		stream.Results = listErrorResults("Unable to list "+l.typeName, err)
+		return
+	}
+	if req.Limit > 0 && int64(len(items)) > req.Limit {
+		items = items[:req.Limit]
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, item := range items {
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.displayName
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(identityIDAttribute), item.id)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				found, err := l.resourceObject(ctx, &result, item.id)
+				if err != nil {
+					result.Diagnostics.AddError("Unable to read "+item.id, err.Error())
+				} else if !found {
+					continue
+				}
+			}
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// resourceObject reads the object id into result.Resource, so that
+// `terraform query` can generate configuration for it. found is false if
+// the object was deleted since it was listed.
+func (l *frameworkListResource) resourceObject(ctx context.Context, result *list.ListResult, id string) (found bool, err error) {
+	val, err := l.def.read(ctx, l.sdk, l.typeName, id)
+	if err != nil || val.IsNull() {
+		return false, err
+	}
+	mp, err := msgpack.Marshal(val, val.Type())
+	if err != nil {
+		return false, err
+	}
+	raw, err := (&tfprotov5.DynamicValue{MsgPack: mp}).Unmarshal(result.Resource.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		return false, err
+	}
+	result.Resource.Raw = raw
+	return true, nil
+}
+
+// listErrorResults returns list results made of a single error.
+func listErrorResults(summary string, err error) func(func(list.ListResult) bool) {
+	var diags fwdiag.Diagnostics
+	diags.AddError(summary, err.Error())
+	return list.ListResultsStreamDiagnostics(diags)
+}
@@ -0,0 +1,199 @@
+package cloudconnexa
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// listTestHandler serves two hosts and a host connector, counting requests
+// for connector credentials.
+func listTestHandler(t *testing.T, credentialCalls *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/hosts":
+			_, _ = w.Write([]byte(`{"content":[{"id":"host-1","name":"alpha"},{"id":"host-2","name":"beta"}],"totalPages":1}`))
+		case r.URL.Path == "/api/v1/hosts/connectors":
+			assert.Equal(t, "host-1", r.URL.Query().Get("hostId"))
+			_, _ = w.Write([]byte(`{"content":[{"id":"connector-id","name":"c","networkItemId":"host-1"}],"totalPages":1}`))
+		case strings.HasPrefix(r.URL.Path, "/api/v1/hosts/connectors/"):
+			connectorCredentialsHandler(credentialCalls).ServeHTTP(w, r)
+		case strings.HasPrefix(r.URL.Path, "/api/v1/hosts/"):
+			id := strings.TrimPrefix(r.URL.Path, "/api/v1/hosts/")
+			_, _ = w.Write([]byte(`{"id":"` + id + `","name":"alpha","internetAccess":"LOCAL"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// listTestResults runs a ListResource request through server and collects
+// its results.
+func listTestResults(t *testing.T, server frameworkTestServer, typeName string, config map[string]tftypes.Value, limit int64, includeResource bool) []tfprotov5.ListResourceResult {
+	t.Helper()
+	ctx := context.Background()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Contains(t, schemas.ListResourceSchemas, typeName)
+	typ := schemas.ListResourceSchemas[typeName].ValueType()
+	vals := map[string]tftypes.Value{}
+	for name, at := range typ.(tftypes.Object).AttributeTypes {
+		vals[name] = tftypes.NewValue(at, nil)
+	}
+	for name, v := range config {
+		vals[name] = v
+	}
+	dv, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, vals))
+	require.NoError(t, err)
+
+	stream, err := server.ListResource(ctx, &tfprotov5.ListResourceRequest{
+		TypeName:        typeName,
+		Config:          &dv,
+		Limit:           limit,
+		IncludeResource: includeResource,
+	})
+	require.NoError(t, err)
+	var results []tfprotov5.ListResourceResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
+
+// listTestServer returns a configured provider server for listTestHandler
+// and its SDKv2 provider.
+func listTestServer(t *testing.T, credentialCalls *atomic.Int32) (frameworkTestServer, *schema.Provider) {
+	t.Helper()
+	c := newHostUnitTestClient(t, listTestHandler(t, credentialCalls))
+	c.ReadRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	c.UpdateRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	p := Provider()
+	p.SetMeta(c)
+	return newFrameworkTestServer(t, p), p
+}
+
+// TestUnitListResources_Registration checks that every list resource lists
+// an existing managed resource type, SDKv2 or framework, which has an
+// identity, and that list resources are advertised.
+func TestUnitListResources_Registration(t *testing.T) {
+	ctx := context.Background()
+	mux, err := ProviderServerFactory()()
+	require.NoError(t, err)
+	meta, err := mux.GetMetadata(ctx, &tfprotov6.GetMetadataRequest{})
+	require.NoError(t, err)
+	require.Empty(t, meta.Diagnostics)
+	assert.Len(t, meta.ListResources, len(listResources()))
+	schemas, err := mux.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemas.Diagnostics)
+	identities, err := mux.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+
+	p := Provider()
+	for name := range listResources() {
+		if r, ok := p.ResourcesMap[name]; ok {
+			assert.NotNil(t, r.Identity, name)
+		} else {
+			assert.Contains(t, frameworkResources(), name)
+		}
+		assert.Contains(t, schemas.ResourceSchemas, name)
+		assert.Contains(t, schemas.ListResourceSchemas, name)
+		assert.Contains(t, identities.IdentitySchemas, name)
+	}
+}
+
+// TestUnitListResource checks that list results carry the identity and
+// display name, respect the limit and include the resource when asked to.
+func TestUnitListResource(t *testing.T) {
+	var credentialCalls atomic.Int32
+	server, p := listTestServer(t, &credentialCalls)
+
+	results := listTestResults(t, server, "cloudconnexa_host", nil, 0, false)
+	require.Len(t, results, 2)
+	for i, want := range []struct{ id, name string }{{"host-1", "alpha"}, {"host-2", "beta"}} {
+		require.Empty(t, results[i].Diagnostics)
+		assert.Equal(t, want.name, results[i].DisplayName)
+		assert.Nil(t, results[i].Resource)
+		identity, err := results[i].Identity.IdentityData.Unmarshal(identityType)
+		require.NoError(t, err)
+		assert.True(t, identity.Equal(tftypes.NewValue(identityType, map[string]tftypes.Value{
+			identityIDAttribute: stringValue(want.id),
+		})), want.id)
+	}
+
+	results = listTestResults(t, server, "cloudconnexa_host", nil, 1, true)
+	require.Len(t, results, 1)
+	require.Empty(t, results[0].Diagnostics)
+	require.NotNil(t, results[0].Resource)
+	typ := p.ResourcesMap["cloudconnexa_host"].CoreConfigSchema().ImpliedType()
+	resource, err := msgpack.Unmarshal(results[0].Resource.MsgPack, typ)
+	require.NoError(t, err)
+	assert.Equal(t, "host-1", resource.GetAttr("id").AsString())
+	assert.Equal(t, "LOCAL", resource.GetAttr("internet_access").AsString())
+}
+
+// TestUnitListResource_ConnectorsOmitCredentials checks that listing
+// connectors with their resource does not fetch their credentials.
+func TestUnitListResource_ConnectorsOmitCredentials(t *testing.T) {
+	var credentialCalls atomic.Int32
+	server, _ := listTestServer(t, &credentialCalls)
+
+	results := listTestResults(t, server, "cloudconnexa_host_connector", map[string]tftypes.Value{
+		"host_id": stringValue("host-1"),
+	}, 0, true)
+	require.NotEmpty(t, results)
+	for _, result := range results {
+		require.Empty(t, result.Diagnostics)
+		require.NotNil(t, result.Resource)
+	}
+	assert.Zero(t, credentialCalls.Load())
+}
+
+// TestUnitListResource_Errors checks the diagnostics for an unconfigured
+// provider and an unknown list resource type.
+func TestUnitListResource_Errors(t *testing.T) {
+	server := newFrameworkTestServer(t, Provider())
+	results := listTestResults(t, server, "cloudconnexa_network", nil, 0, false)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Diagnostics, 1)
+	assert.Contains(t, results[0].Diagnostics[0].Detail, "not been configured")
+
+	stream, err := server.ListResource(context.Background(), &tfprotov5.ListResourceRequest{TypeName: "cloudconnexa_unknown"})
+	require.NoError(t, err)
+	for result := range stream.Results {
+		require.Len(t, result.Diagnostics, 1)
+		assert.Equal(t, "List Resource Type Not Found", result.Diagnostics[0].Summary)
+	}
+}
+
+// identityType is the identity object type of resources using withIDIdentity.
+var identityType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{identityIDAttribute: tftypes.String}}
+
+// TestUnitWithIDIdentity checks that the wrapped read sets the identity.
+func TestUnitWithIDIdentity(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			return nil
+		},
+	}
+	withIDIdentity(r)
+	d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaFunc(), nil)
+	d.SetId("object-id")
+	require.False(t, r.ReadContext(context.Background(), d, nil).HasError())
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "object-id", identity.Get("id"))
+}
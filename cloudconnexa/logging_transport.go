@@ -0,0 +1,162 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// requestIDHeader carries the request ID of every API call while TRACE
+// logging is enabled, so provider logs can be correlated with CloudConnexa
+// support requests. The ID is logged at DEBUG either way.
+const requestIDHeader = "X-Request-Id"
+
+// maxLoggedBody caps how much of a request or response body is logged.
+const maxLoggedBody = 16 << 10
+
+// apiPathPrefix is stripped from request paths to derive log subsystems.
+const apiPathPrefix = "/api/v1/"
+
+// pathWordPattern matches API path segments that name a collection rather
+// than an object ID.
+var pathWordPattern = regexp.MustCompile(`^[a-z][a-z-]*$`)
+
+// loggingTransport is an http.RoundTripper that logs every API request and
+// response through tflog. Metadata (method, path, status, latency, request
+// ID) is logged at DEBUG, in a subsystem named after the API resource type,
+// e.g. `api_networks_connectors`. When TRACE logging is enabled, requests
+// also carry the request ID in a header and redacted headers and bodies are
+// logged; bodies are never read otherwise. Enable it with `TF_LOG=DEBUG` or
+// `TF_LOG_PROVIDER=TRACE`.
+type loggingTransport struct {
+	next  http.RoundTripper
+	trace bool
+}
+
+// newLoggingTransport wraps next with request/response logging.
+func newLoggingTransport(next http.RoundTripper) *loggingTransport {
+	return &loggingTransport{next: next, trace: traceLoggingEnabled()}
+}
+
+// traceLoggingEnabled reports whether Terraform keeps the provider's TRACE
+// logs. Terraform filters provider logs by TF_LOG_PROVIDER, or TF_LOG when
+// it is unset, and the provider process inherits both; TF_LOG=JSON also
+// selects TRACE.
+func traceLoggingEnabled() bool {
+	level := os.Getenv("TF_LOG_PROVIDER")
+	if level == "" {
+		level = os.Getenv("TF_LOG")
+	}
+	level = strings.ToUpper(strings.TrimSpace(level))
+	return level == "TRACE" || level == "JSON"
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	subsystem := apiSubsystem(req.URL.Path)
+	ctx := tflog.NewSubsystem(req.Context(), subsystem)
+	ctx = tflog.SubsystemSetField(ctx, subsystem, "http_method", req.Method)
+	ctx = tflog.SubsystemSetField(ctx, subsystem, "http_path", req.URL.Path)
+
+	requestID := req.Header.Get(requestIDHeader)
+	if requestID == "" {
+		requestID = newRequestID()
+		if t.trace {
+			req = req.Clone(req.Context())
+			req.Header.Set(requestIDHeader, requestID)
+		}
+	}
+	ctx = tflog.SubsystemSetField(ctx, subsystem, "request_id", requestID)
+
+	tflog.SubsystemDebug(ctx, subsystem, "Sending CloudConnexa API request")
+	if t.trace {
+		tflog.SubsystemTrace(ctx, subsystem, "CloudConnexa API request details", map[string]interface{}{
+			"http_headers": redactHeaders(req.Header),
+			"http_body":    redactBody(req.URL.Path, requestBody(req)),
+		})
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		tflog.SubsystemDebug(ctx, subsystem, "CloudConnexa API request failed", map[string]interface{}{
+			"latency_ms": latency.Milliseconds(),
+			"error":      redactString(err.Error()),
+		})
+		return resp, err
+	}
+
+	if id := resp.Header.Get(requestIDHeader); id != "" && id != requestID {
+		ctx = tflog.SubsystemSetField(ctx, subsystem, "server_request_id", id)
+	}
+	tflog.SubsystemDebug(ctx, subsystem, "Received CloudConnexa API response", map[string]interface{}{
+		"http_status": resp.StatusCode,
+		"latency_ms":  latency.Milliseconds(),
+	})
+
+	if t.trace {
+		var body []byte
+		body, resp.Body = peekBody(resp.Body)
+		tflog.SubsystemTrace(ctx, subsystem, "CloudConnexa API response details", map[string]interface{}{
+			"http_headers": redactHeaders(resp.Header),
+			"http_body":    redactBody(req.URL.Path, body),
+		})
+	}
+	return resp, nil
+}
+
+// apiSubsystem derives the log subsystem from an API path, e.g.
+// /api/v1/networks/connectors/{id}/profile becomes `api_networks_connectors`.
+func apiSubsystem(path string) string {
+	parts := []string{"api"}
+	for _, seg := range strings.Split(strings.TrimPrefix(path, apiPathPrefix), "/") {
+		if !pathWordPattern.MatchString(seg) || len(parts) == 3 {
+			break
+		}
+		parts = append(parts, strings.ReplaceAll(seg, "-", "_"))
+	}
+	return strings.Join(parts, "_")
+}
+
+// requestBody returns a copy of the request body without consuming it.
+func requestBody(req *http.Request) []byte {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = rc.Close() }()
+	b, _ := io.ReadAll(io.LimitReader(rc, maxLoggedBody))
+	return b
+}
+
+// peekBody reads up to maxLoggedBody bytes of body for logging and returns a
+// replacement body that still yields the complete content.
+func peekBody(body io.ReadCloser) ([]byte, io.ReadCloser) {
+	if body == nil || body == http.NoBody {
+		return nil, body
+	}
+	head, _ := io.ReadAll(io.LimitReader(body, maxLoggedBody))
+	return head, &struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), body), body}
+}
+
+// newRequestID returns a random 16 character hex identifier.
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
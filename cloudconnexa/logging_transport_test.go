@@ -0,0 +1,136 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnitLoggingTransport checks that a request/response pair is logged with
+// its metadata, that secrets never reach the log and that the response body
+// is still delivered in full.
+func TestUnitLoggingTransport(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER", "")
+	t.Setenv("TF_LOG", "trace")
+	var gotRequestID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequestID = r.Header.Get(requestIDHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"c1","token":"resp-secret"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/v1/networks/connectors?networkId=n1",
+		bytes.NewBufferString(`{"name":"edge","ipSecConfig":{"preSharedKey":"req-secret"}}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer header-secret")
+
+	resp, err := (&http.Client{Transport: newLoggingTransport(http.DefaultTransport)}).Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.JSONEq(t, `{"id":"c1","token":"resp-secret"}`, string(body))
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	var sawResponse bool
+	for _, e := range entries {
+		assert.Equal(t, "api_networks_connectors", e["@module"].(string)[len("provider."):])
+		assert.Equal(t, gotRequestID, e["request_id"])
+		if e["@message"] == "Received CloudConnexa API response" {
+			sawResponse = true
+			assert.EqualValues(t, http.StatusCreated, e["http_status"])
+			assert.Equal(t, "/api/v1/networks/connectors", e["http_path"])
+			assert.Equal(t, http.MethodPost, e["http_method"])
+			assert.Contains(t, e, "latency_ms")
+		}
+	}
+	assert.True(t, sawResponse)
+	assert.NotEmpty(t, gotRequestID)
+	for _, secret := range []string{"req-secret", "resp-secret", "header-secret"} {
+		assert.NotContains(t, logs.String(), secret)
+	}
+}
+
+// TestUnitLoggingTransport_NoTrace checks that without TRACE logging the
+// request is sent unchanged and no body is read for logging, while the DEBUG
+// entries still carry a request ID.
+func TestUnitLoggingTransport_NoTrace(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER", "DEBUG")
+	t.Setenv("TF_LOG", "TRACE")
+	body := io.NopCloser(bytes.NewBufferString(`{"id":"c1"}`))
+	var sent *http.Request
+	rt := newLoggingTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent = req
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: body}, nil
+	}))
+	require.False(t, rt.trace)
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://acme.api.openvpn.com/api/v1/networks", nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+
+	assert.Same(t, req, sent)
+	assert.Empty(t, sent.Header.Get(requestIDHeader))
+	assert.Equal(t, body, resp.Body)
+	assert.NotContains(t, logs.String(), "details")
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Sending CloudConnexa API request", entries[0]["@message"])
+	assert.Equal(t, "Received CloudConnexa API response", entries[1]["@message"])
+	for _, e := range entries {
+		assert.Equal(t, "debug", e["@level"])
+		assert.Regexp(t, `^[0-9a-f]{16}$`, e["request_id"])
+	}
+	assert.Equal(t, entries[0]["request_id"], entries[1]["request_id"])
+}
+
+// TestUnitTraceLoggingEnabled checks which log settings enable TRACE.
+func TestUnitTraceLoggingEnabled(t *testing.T) {
+	for _, tc := range []struct {
+		provider, log string
+		want          bool
+	}{
+		{"", "", false},
+		{"", "TRACE", true},
+		{"", "json", true},
+		{"", "DEBUG", false},
+		{"trace", "DEBUG", true},
+		{"INFO", "TRACE", false},
+	} {
+		t.Setenv("TF_LOG_PROVIDER", tc.provider)
+		t.Setenv("TF_LOG", tc.log)
+		assert.Equal(t, tc.want, traceLoggingEnabled(), "TF_LOG_PROVIDER=%q TF_LOG=%q", tc.provider, tc.log)
+	}
+}
+
+// TestUnitAPISubsystem checks how API paths map to log subsystems.
+func TestUnitAPISubsystem(t *testing.T) {
+	for path, want := range map[string]string{
+		"/api/v1/networks": "api_networks",
+		"/api/v1/networks/5b1e0c6a-1f1a-4c0e-9b1e-1a2b3c4d5e6f": "api_networks",
+		"/api/v1/networks/connectors/abc1/profile":              "api_networks_connectors",
+		"/api/v1/dns-records/page":                              "api_dns_records_page",
+		"/api/v1/oauth/token":                                   "api_oauth_token",
+	} {
+		assert.Equal(t, want, apiSubsystem(path), path)
+	}
+}
@@ -2,14 +2,25 @@ package cloudconnexa
 
 import (
 	"bufio"
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
 )
 
+// FakeAPIEnvVar is the environment variable that runs the acceptance tests
+// against an in-memory fake of the CloudConnexa API instead of a real
+// account when set to "1".
+const FakeAPIEnvVar = "TF_ACC_FAKE"
+
 // TestMain loads CloudConnexa credentials from a local .env file before
 // running the package tests. The file is optional: when missing, tests fall
 // back to whatever is already in the environment (CI populates the same
@@ -18,6 +29,10 @@ import (
 // When a .env file is loaded, TF_ACC is set to "1" by default so acceptance
 // tests participate in the run. Callers that want to opt out can export
 // TF_ACC=0 explicitly before invoking `go test`.
+//
+// With TF_ACC_FAKE=1 the acceptance tests run against an in-memory fake of
+// the API instead, without network access or real credentials; the fake's
+// credentials, base URL and CA certificate replace those from .env.
 func TestMain(m *testing.M) {
 	var loaded bool
 	for _, p := range []string{".env", "../.env"} {
@@ -25,6 +40,15 @@ func TestMain(m *testing.M) {
 			loaded = true
 		}
 	}
+	stop := func() {}
+	if os.Getenv(FakeAPIEnvVar) == "1" {
+		var err error
+		if stop, err = startFakeAPI(); err != nil {
+			fmt.Fprintf(os.Stderr, "starting the fake CloudConnexa API: %s\n", err)
+			os.Exit(1)
+		}
+		loaded = true
+	}
 	if loaded {
 		if _, set := os.LookupEnv(resource.EnvTfAcc); !set {
 			_ = os.Setenv(resource.EnvTfAcc, "1")
@@ -33,7 +57,63 @@ func TestMain(m *testing.M) {
 	// testBaseURL was initialized at package-init time, before TestMain ran,
 	// so reseat it now that .env has populated the environment.
 	testBaseURL = os.Getenv(BaseURLEnvVar)
-	os.Exit(m.Run())
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+// startFakeAPI starts the fake CloudConnexa API and points the provider and
+// the test helpers at it through the environment: the client credentials,
+// CLOUDCONNEXA_BASE_URL and CLOUDCONNEXA_CA_CERT_FILE. Helpers that need a
+// client outside the tested configuration get one from testAccClient, which
+// trusts the same CA file.
+//
+// Returns:
+//   - func(): A function that stops the fake and removes its CA file
+//   - error: An error if the CA file could not be written
+func startFakeAPI() (func(), error) {
+	const clientID, clientSecret = "fake-client-id", "fake-client-secret"
+	srv := fakeapi.New(fakeapi.Options{
+		ClientID:             clientID,
+		ClientSecret:         clientSecret,
+		ConnectorOnlineAfter: time.Second,
+	})
+	dir, err := os.MkdirTemp("", "cloudconnexa-fake-api")
+	if err != nil {
+		srv.Close()
+		return nil, err
+	}
+	stop := func() {
+		srv.Close()
+		_ = os.RemoveAll(dir)
+	}
+	caFile := filepath.Join(dir, "ca.pem")
+	if err := srv.WriteCACert(caFile); err != nil {
+		stop()
+		return nil, err
+	}
+	for k, v := range map[string]string{
+		ClientIDEnvVar:     clientID,
+		ClientSecretEnvVar: clientSecret,
+		BaseURLEnvVar:      srv.URL,
+		CACertFileEnvVar:   caFile,
+	} {
+		_ = os.Setenv(k, v)
+	}
+	return stop, nil
+}
+
+// testAccClient returns a client configured like the provider from the
+// environment, including the CA certificate of the fake API, for test
+// helpers that call the API directly.
+func testAccClient(t *testing.T) *cloudconnexa.Client {
+	t.Helper()
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{"base_url": testBaseURL}))
+	if diags.HasError() {
+		t.Fatalf("failed to create CloudConnexa client: %s: %s", diags[0].Summary, diags[0].Detail)
+	}
+	return p.Meta().(*cloudconnexa.Client)
 }
 
 // loadDotEnv reads simple KEY=VALUE pairs from the file at path and exports
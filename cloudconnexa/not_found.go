@@ -0,0 +1,75 @@
+package cloudconnexa
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// notFoundSentinels are the errors the go-client returns when a lookup by
+// listing finds no object with the requested ID.
+var notFoundSentinels = []error{
+	cloudconnexa.ErrDNSRecordNotFound,
+	cloudconnexa.ErrUserGroupNotFound,
+	cloudconnexa.ErrUserNotFound,
+}
+
+// isNotFoundErr reports whether err means that the requested CloudConnexa
+// object does not exist: the API answered 404, or the go-client found no
+// matching object while listing.
+//
+// Parameters:
+//   - err: The error returned by a go-client call
+//
+// Returns:
+//   - bool: True if the object does not exist
+func isNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	var resp interface{ StatusCode() int }
+	if errors.As(err, &resp) && resp.StatusCode() == http.StatusNotFound {
+		return true
+	}
+	for _, sentinel := range notFoundSentinels {
+		if errors.Is(err, sentinel) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeFromState clears the ID of a resource whose object was deleted
+// outside Terraform, so the next plan recreates it instead of failing.
+//
+// Parameters:
+//   - ctx: The context used for logging
+//   - d: The Terraform resource data
+//   - kind: The kind of object, e.g. "host connector", used in the warning
+func removeFromState(ctx context.Context, d *schema.ResourceData, kind string) {
+	tflog.Warn(ctx, "CloudConnexa object not found, removing it from state", map[string]interface{}{
+		"kind": kind,
+		"id":   d.Id(),
+	})
+	d.SetId("")
+}
+
+// removeResourceFromState is removeFromState for framework resources.
+//
+// Parameters:
+//   - ctx: The context used for logging
+//   - state: The state of the resource in the read response
+//   - kind: The kind of object, e.g. "route", used in the warning
+//   - id: The ID of the object
+func removeResourceFromState(ctx context.Context, state *tfsdk.State, kind, id string) {
+	tflog.Warn(ctx, "CloudConnexa object not found, removing it from state", map[string]interface{}{
+		"kind": kind,
+		"id":   id,
+	})
+	state.RemoveResource(ctx)
+}
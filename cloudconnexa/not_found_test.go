@@ -0,0 +1,91 @@
+package cloudconnexa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusError is an API error carrying an HTTP status, like the go-client's
+// ErrClientResponse.
+type statusError int
+
+func (e statusError) Error() string   { return fmt.Sprintf("status code: %d", int(e)) }
+func (e statusError) StatusCode() int { return int(e) }
+
+func TestUnitIsNotFoundErr(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":                {nil, false},
+		"404":                {statusError(http.StatusNotFound), true},
+		"wrapped 404":        {fmt.Errorf("get: %w", statusError(http.StatusNotFound)), true},
+		"400":                {statusError(http.StatusBadRequest), false},
+		"500":                {statusError(http.StatusInternalServerError), false},
+		"user sentinel":      {cloudconnexa.ErrUserNotFound, true},
+		"user group wrapped": {fmt.Errorf("lookup: %w", cloudconnexa.ErrUserGroupNotFound), true},
+		"dns record":         {cloudconnexa.ErrDNSRecordNotFound, true},
+		"other":              {errors.New("connection refused"), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isNotFoundErr(tc.err))
+		})
+	}
+}
+
+func TestUnitFakeAPIDeletedOutsideTerraform(t *testing.T) {
+	_, p := newFakeAPIProvider(t, fakeapi.Options{})
+	ctx := context.Background()
+	c := clientFromMeta(ctx, p.Meta())
+	host := p.ResourcesMap["cloudconnexa_host"]
+	connector := p.ResourcesMap["cloudconnexa_host_connector"]
+	user := p.ResourcesMap["cloudconnexa_user"]
+
+	hd := schema.TestResourceDataRaw(t, host.Schema, map[string]interface{}{"name": "web"})
+	require.False(t, host.CreateContext(ctx, hd, p.Meta()).HasError())
+	cd := schema.TestResourceDataRaw(t, connector.Schema, map[string]interface{}{
+		"name":          "web-1",
+		"vpn_region_id": "us-east-1",
+		"host_id":       hd.Id(),
+	})
+	require.False(t, connector.CreateContext(ctx, cd, p.Meta()).HasError())
+	ud := schema.TestResourceDataRaw(t, user.Schema, map[string]interface{}{
+		"username": "jdoe",
+		"email":    "jdoe@example.com",
+		"group_id": "",
+	})
+	require.False(t, user.CreateContext(ctx, ud, p.Meta()).HasError())
+
+	// Deleting the host outside Terraform removes its connector as well.
+	require.NoError(t, c.Hosts.Delete(hd.Id()))
+	require.NoError(t, c.Users.Delete(ud.Id()))
+
+	for name, tc := range map[string]struct {
+		r *schema.Resource
+		d *schema.ResourceData
+	}{
+		"host":      {host, hd},
+		"connector": {connector, cd},
+		"user":      {user, ud},
+	} {
+		t.Run(name, func(t *testing.T) {
+			id := tc.d.Id()
+			diags := tc.r.ReadContext(ctx, tc.d, p.Meta())
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Empty(t, tc.d.Id(), "removed from state")
+
+			tc.d.SetId(id)
+			diags = tc.r.DeleteContext(ctx, tc.d, p.Meta())
+			assert.False(t, diags.HasError(), "deleting a missing object succeeds: %v", diags)
+		})
+	}
+}
@@ -3,12 +3,13 @@ package cloudconnexa
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
-
-	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ClientIDEnvVar is the environment variable name for the CloudConnexa client ID
@@ -26,6 +27,20 @@ var version = "v1.2.6"
 // Token represents the authentication token structure returned by the CloudConnexa API
 type Token struct {
 	AccessToken string `json:"access_token"`
+	// ExpiresIn is the token lifetime in seconds, when the API reports it.
+	ExpiresIn int64 `json:"expires_in,omitempty"`
+	// Expiry is the time the token expires, derived from ExpiresIn. The zero
+	// value means the token does not expire.
+	Expiry time.Time `json:"-"`
+}
+
+// valid reports whether tok holds an access token that does not expire
+// within tokenExpiryDelta of now. Tokens without an expiry never expire.
+func (tok Token) valid(now time.Time) bool {
+	if tok.AccessToken == "" {
+		return false
+	}
+	return tok.Expiry.IsZero() || now.Add(tokenExpiryDelta).Before(tok.Expiry)
 }
 
 // Provider returns a Terraform provider for CloudConnexa.
@@ -34,11 +49,11 @@ type Token struct {
 // Returns:
 //   - *schema.Provider: A configured Terraform provider instance
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"client_id": {
 				Description: "The authentication client_id used to connect to CloudConnexa API. The value can be sourced from " +
-					"the `CLOUDCONNEXA_CLIENT_ID` environment variable.",
+					"the `CLOUDCONNEXA_CLIENT_ID` environment variable or from the selected `profile`.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
@@ -46,30 +61,161 @@ func Provider() *schema.Provider {
 			},
 			"client_secret": {
 				Description: "The authentication client_secret used to connect to CloudConnexa API. The value can be sourced from " +
-					"the `CLOUDCONNEXA_CLIENT_SECRET` environment variable.",
+					"the `CLOUDCONNEXA_CLIENT_SECRET` environment variable or from the selected `profile`.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc(ClientSecretEnvVar, nil),
 			},
+			"access_token": {
+				Description: "A pre-issued CloudConnexa API access token. When set, the OAuth client-credentials handshake " +
+					"is skipped and `client_id` and `client_secret` are ignored. The token is not refreshed. The " +
+					"value can be sourced from the `CLOUDCONNEXA_ACCESS_TOKEN` environment variable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc(AccessTokenEnvVar, nil),
+			},
 			"base_url": {
-				Description:  "The target CloudConnexa Base API URL in the format `https://[companyName].api.openvpn.com`",
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"base_url", "cloud_id"},
+				Description: "The target CloudConnexa Base API URL in the format `https://[companyName].api.openvpn.com`. " +
+					"Conflicts with `cloud_id`; when neither is set, the value is taken from the selected `profile`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"cloud_id"},
 			},
 			"cloud_id": {
 				Description: "Cloud ID",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"profile": {
+				Description: "Name of the profile in the credentials file to read `cloud_id`/`base_url`, `client_id` and " +
+					"`client_secret` from. A selected profile is used as a whole: settings in the provider block and the " +
+					"environment may only fill values it leaves empty, and differing values are an error. The value can " +
+					"be sourced from the `CLOUDCONNEXA_PROFILE` environment variable. Defaults to the `default` profile " +
+					"when it exists.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(ProfileEnvVar, ""),
+			},
+			"credentials_file": {
+				Description: "Path to the credentials file holding named profiles. The value can be sourced from the " +
+					"`CLOUDCONNEXA_CREDENTIALS_FILE` environment variable. Defaults to `~/.cloudconnexa/credentials`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(CredentialsFileEnvVar, ""),
+			},
+			"max_concurrent_requests": {
+				Description: "Maximum number of API requests this provider keeps in flight at once, shared by every resource " +
+					"and data source regardless of Terraform's `-parallelism`. `0` means unlimited. Defaults to `0`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"requests_per_second": {
+				Description: "Maximum sustained rate of API requests issued by this provider, including retries. `0` means " +
+					"unlimited. Defaults to `0`.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.0,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"read_only": {
+				Description: "Refuse every create, update and delete, including connector and IPsec actions, before any API " +
+					"call is made. Reads, refreshes and data sources keep working, which makes it suitable for drift " +
+					"detection pipelines. The value can be sourced from the `CLOUDCONNEXA_READ_ONLY` environment variable. " +
+					"Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(ReadOnlyEnvVar, false),
+			},
+			"proxy_url": {
+				Description: "URL of the HTTP(S) or SOCKS5 proxy used for every API request, e.g. `http://proxy.example.com:3128`. " +
+					"When not set, the proxy is taken from the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"cache_ttl": {
+				Description: "How long successful API read responses are cached in memory and shared by all resources " +
+					"and data sources of this provider, as a Go duration string, e.g. `30s`. Any change made through the " +
+					"provider drops the cached responses of the changed object type and of the types embedding it. " +
+					"Changes made outside of Terraform may not be seen until the cached responses expire. Defaults to " +
+					"`0s`, which disables the cache.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0s",
+				ValidateFunc: validateDuration,
+			},
+			"ca_cert_file": {
+				Description: "Path to a PEM encoded CA bundle trusted in addition to the system roots, e.g. the CA of a " +
+					"TLS-intercepting proxy. The value can be sourced from the `CLOUDCONNEXA_CA_CERT_FILE` environment " +
+					"variable. Conflicts with `ca_cert_pem`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc(CACertFileEnvVar, nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+			"ca_cert_pem": {
+				Description:   "PEM encoded CA certificates trusted in addition to the system roots. Conflicts with `ca_cert_file`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+			"insecure_skip_verify": {
+				Description: "Disable verification of the API server's TLS certificate. This makes the connection vulnerable " +
+					"to interception and must only be used for troubleshooting. Defaults to `false`.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"min_tls_version": {
+				Description:  fmt.Sprintf("Minimum TLS version accepted for API connections. One of `1.2` or `1.3`. Defaults to `%s`.", defaultMinTLSVersion),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultMinTLSVersion,
+				ValidateFunc: validation.StringInSlice([]string{"1.2", "1.3"}, false),
+			},
+			"retry": {
+				Description: "Retry behaviour for rate-limited (HTTP 429), server-side (HTTP 5xx) and connection-reset API " +
+					"failures. Retries use jittered exponential backoff and honor the `Retry-After` header. Requests creating " +
+					"objects are only retried on HTTP 429, or HTTP 503 with `Retry-After`, so that they are never applied twice. " +
+					"Retries are enabled with the default values when the block is omitted.",
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Description:  fmt.Sprintf("Maximum number of attempts per API request, including the first one. Set to `1` to disable retries. Defaults to `%d`.", defaultRetryMaxAttempts),
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultRetryMaxAttempts,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_backoff": {
+							Description:  fmt.Sprintf("Minimum wait between attempts, as a Go duration string. Defaults to `%s`.", defaultRetryMinBackoff),
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      defaultRetryMinBackoff.String(),
+							ValidateFunc: validateDuration,
+						},
+						"max_backoff": {
+							Description:  fmt.Sprintf("Maximum wait between attempts, as a Go duration string. Defaults to `%s`.", defaultRetryMaxBackoff),
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      defaultRetryMaxBackoff.String(),
+							ValidateFunc: validateDuration,
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"cloudconnexa_network":             resourceNetwork(),
 			"cloudconnexa_network_connector":   resourceNetworkConnector(),
 			"cloudconnexa_host_connector":      resourceHostConnector(),
-			"cloudconnexa_route":               resourceRoute(),
-			"cloudconnexa_dns_record":          resourceDnsRecord(),
 			"cloudconnexa_user":                resourceUser(),
 			"cloudconnexa_host":                resourceHost(),
 			"cloudconnexa_user_group":          resourceUserGroup(),
@@ -103,9 +249,18 @@ func Provider() *schema.Provider {
 			"cloudconnexa_sessions":            dataSourceSessions(),
 			"cloudconnexa_devices":             dataSourceDevices(),
 			"cloudconnexa_device":              dataSourceDevice(),
+			"cloudconnexa_connector_bootstrap": dataSourceConnectorBootstrap(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+	lists := listResources()
+	for name, r := range p.ResourcesMap {
+		guardReadOnly(name, r)
+		if _, ok := lists[name]; ok {
+			withIDIdentity(r)
+		}
+	}
+	return p
 }
 
 // providerConfigure configures the CloudConnexa client with the provided credentials and base URL.
@@ -119,20 +274,70 @@ func Provider() *schema.Provider {
 //   - interface{}: The configured CloudConnexa client
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred during configuration
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	clientId := d.Get("client_id").(string)
-	clientSecret := d.Get("client_secret").(string)
-	baseUrl := d.Get("base_url").(string)
-	if baseUrl == "" {
-		cloudId := d.Get("cloud_id").(string)
-		if cloudId != "" && !cloudIDPattern.MatchString(cloudId) {
-			return nil, diag.Errorf("Invalid cloud_id format: must contain only alphanumeric characters and hyphens")
-		}
-		if cloudId != "" {
-			baseUrl = "https://" + cloudId + ".api.openvpn.com"
-		}
+	creds, err := resolveCredentials(ctx, providerCredentials{
+		ClientID:     d.Get("client_id").(string),
+		ClientSecret: d.Get("client_secret").(string),
+		BaseURL:      d.Get("base_url").(string),
+		CloudID:      d.Get("cloud_id").(string),
+	}, d.Get("profile").(string), d.Get("credentials_file").(string))
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to load CloudConnexa credentials",
+			Detail:   err.Error(),
+		}}
+	}
+	clientId := creds.ClientID
+	clientSecret := creds.ClientSecret
+	baseUrl, err := creds.baseURL()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	auth := authConfig{
+		AccessToken:  d.Get("access_token").(string),
+		ClientID:     clientId,
+		ClientSecret: clientSecret,
+	}
+	if baseUrl == "" && auth.configured() {
+		return nil, diag.Errorf("one of base_url or cloud_id must be set in the provider configuration or the selected profile")
 	}
-	cloudConnexaClient, err := cloudconnexa.NewClient(baseUrl, clientId, clientSecret)
 	var diags diag.Diagnostics
+	base := newBaseTransport()
+	tc := transportConfig{
+		ProxyURL:           d.Get("proxy_url").(string),
+		CACertFile:         d.Get("ca_cert_file").(string),
+		CACertPEM:          d.Get("ca_cert_pem").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		MinTLSVersion:      d.Get("min_tls_version").(string),
+	}
+	if err := tc.apply(base); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if tc.InsecureSkipVerify {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "TLS certificate verification is disabled",
+			Detail: "insecure_skip_verify is set: the provider does not verify the CloudConnexa API certificate, so " +
+				"credentials and API traffic can be intercepted. Use ca_cert_file or ca_cert_pem to trust a private CA instead.",
+		})
+	}
+
+	retry, err := expandRetryConfig(d.Get("retry").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	limiter := newLimitTransport(newLoggingTransport(base), d.Get("max_concurrent_requests").(int), d.Get("requests_per_second").(float64))
+	var transport http.RoundTripper = newRetryTransport(limiter, retry)
+	cacheTTL, err := time.ParseDuration(d.Get("cache_ttl").(string))
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("invalid cache_ttl: %w", err))
+	}
+	if cacheTTL > 0 {
+		transport = newCacheTransport(transport, cacheTTL)
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	cloudConnexaClient, err := newAPIClient(ctx, httpClient, baseUrl, auth)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -141,6 +346,21 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		})
 		return nil, diags
 	}
-	cloudConnexaClient.UserAgent = fmt.Sprintf("terraform-provider-cloudconnexa/%v", version)
-	return cloudConnexaClient, nil
+	if d.Get("read_only").(bool) {
+		cloudConnexaClient = enableReadOnly(cloudConnexaClient)
+	}
+	return cloudConnexaClient, diags
+}
+
+// validateDuration is a schema.SchemaValidateFunc that accepts non-negative
+// Go duration strings such as `500ms` or `1m30s`.
+func validateDuration(v interface{}, k string) (warnings []string, errs []error) {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a valid duration (e.g. 500ms, 2s, 1m): %v", k, err)}
+	}
+	if d < 0 {
+		return nil, []error{fmt.Errorf("%q must not be negative", k)}
+	}
+	return nil, nil
 }
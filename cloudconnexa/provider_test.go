@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
 
@@ -27,15 +28,17 @@ var testBaseURL = os.Getenv(BaseURLEnvVar)
 // testAccProvider holds the Terraform provider instance for testing
 var testAccProvider *schema.Provider
 
-// testAccProviderFactories maps provider names to factory functions that create provider instances
-var testAccProviderFactories map[string]func() (*schema.Provider, error)
+// testAccProtoV6ProviderFactories maps provider names to factory functions
+// that create protocol servers for testAccProvider, so that tests can reach
+// its API client through testAccProvider.Meta()
+var testAccProtoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 
 // init initializes the test provider and its factory function
 func init() {
 	testAccProvider = Provider()
-	testAccProviderFactories = map[string]func() (*schema.Provider, error){
-		"cloudconnexa": func() (*schema.Provider, error) {
-			return testAccProvider, nil
+	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"cloudconnexa": func() (tfprotov6.ProviderServer, error) {
+			return newMuxServer(testAccProvider)
 		},
 	}
 }
@@ -51,6 +54,9 @@ func TestProvider(t *testing.T) {
 	// must have the required error when the credentials are not set
 	t.Setenv(ClientIDEnvVar, "")
 	t.Setenv(ClientSecretEnvVar, "")
+	t.Setenv(ProfileEnvVar, "")
+	t.Setenv(CredentialsFileEnvVar, "")
+	t.Setenv("HOME", t.TempDir())
 	rc := terraform.ResourceConfig{}
 	diags := Provider().Configure(context.Background(), &rc)
 	assert.True(t, diags.HasError())
@@ -0,0 +1,121 @@
+package cloudconnexa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+)
+
+// ReadOnlyEnvVar is the environment variable name that enables the provider's read-only mode
+const ReadOnlyEnvVar = "CLOUDCONNEXA_READ_ONLY"
+
+// readOnlyPostSuffixes lists endpoints that are called with POST but only
+// read data: the connector profile and token lookups done by Read.
+var readOnlyPostSuffixes = []string{"/profile", "/profile/encrypt"}
+
+// readOnlyError is returned for API requests blocked by read-only mode.
+type readOnlyError struct {
+	method string
+	path   string
+}
+
+// Error implements error.
+func (e *readOnlyError) Error() string {
+	return fmt.Sprintf("refusing %s %s: the CloudConnexa provider is in read-only mode", e.method, e.path)
+}
+
+// readOnlyTransport is an http.RoundTripper that rejects every API request
+// that could modify CloudConnexa objects before it is sent. It is the last
+// line of defence of read-only mode; CRUD functions are stopped earlier by
+// guardReadOnly.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+// newReadOnlyTransport wraps next with the read-only check.
+func newReadOnlyTransport(next http.RoundTripper) *readOnlyTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &readOnlyTransport{next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOnlyRequest(req) {
+		return nil, &readOnlyError{method: req.Method, path: req.URL.Path}
+	}
+	return t.next.RoundTrip(req)
+}
+
+// isReadOnlyRequest reports whether req only reads data.
+func isReadOnlyRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		for _, suffix := range readOnlyPostSuffixes {
+			if strings.HasSuffix(req.URL.Path, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// enableReadOnly returns a copy of c that rejects every modifying API
+// request.
+func enableReadOnly(c *cloudconnexa.Client) *cloudconnexa.Client {
+	hc := c.HTTPClient()
+	return c.WithHTTPClient(&http.Client{Transport: newReadOnlyTransport(hc.Transport), Timeout: hc.Timeout})
+}
+
+// isReadOnly reports whether the provider meta m is in read-only mode.
+func isReadOnly(m interface{}) bool {
+	c, ok := m.(*cloudconnexa.Client)
+	if !ok || c == nil || c.HTTPClient() == nil {
+		return false
+	}
+	_, ok = c.HTTPClient().Transport.(*readOnlyTransport)
+	return ok
+}
+
+// readOnlyDiagnostics returns the error reported when operation on
+// resourceType is refused in read-only mode.
+func readOnlyDiagnostics(resourceType, operation string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "CloudConnexa provider is in read-only mode",
+		Detail: fmt.Sprintf("Refusing to %s %s because the provider is configured with read_only = true or the %s "+
+			"environment variable. Plans and refreshes work as usual; remove the setting to apply changes.",
+			operation, resourceType, ReadOnlyEnvVar),
+	}}
+}
+
+// guardReadOnly wraps the Create, Update and Delete functions of r so that
+// they fail before any API call when the provider is in read-only mode.
+//
+// Parameters:
+//   - resourceType: The resource type name, e.g. `cloudconnexa_network`
+//   - r: The resource to guard
+func guardReadOnly(resourceType string, r *schema.Resource) {
+	guard := func(operation string, fn schema.CreateContextFunc) schema.CreateContextFunc {
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if isReadOnly(m) {
+				return readOnlyDiagnostics(resourceType, operation)
+			}
+			return fn(ctx, d, m)
+		}
+	}
+	r.CreateContext = guard("create", r.CreateContext)
+	r.UpdateContext = schema.UpdateContextFunc(guard("update", schema.CreateContextFunc(r.UpdateContext)))
+	r.DeleteContext = schema.DeleteContextFunc(guard("delete", schema.CreateContextFunc(r.DeleteContext)))
+}
@@ -0,0 +1,103 @@
+package cloudconnexa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// TestUnitReadOnly_GuardsCRUD checks that create, update and delete fail
+// before any API call while reads still reach the API.
+func TestUnitReadOnly_GuardsCRUD(t *testing.T) {
+	var calls atomic.Int32
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{"id":"host-id","name":"h","internetAccess":"SPLIT_TUNNEL_ON"}`))
+	}))
+	c = enableReadOnly(c)
+	assert.True(t, isReadOnly(c))
+
+	r := Provider().ResourcesMap["cloudconnexa_host"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "h"})
+	d.SetId("host-id")
+	ctx := context.Background()
+
+	for op, fn := range map[string]func(context.Context, *schema.ResourceData, interface{}) error{
+		"create": func(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+			return diagsErr(r.CreateContext(ctx, d, m))
+		},
+		"update": func(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+			return diagsErr(r.UpdateContext(ctx, d, m))
+		},
+		"delete": func(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+			return diagsErr(r.DeleteContext(ctx, d, m))
+		},
+	} {
+		err := fn(ctx, d, c)
+		require.Error(t, err, op)
+		assert.Contains(t, err.Error(), "read-only mode", op)
+	}
+	assert.Zero(t, calls.Load())
+
+	diags := r.ReadContext(ctx, d, c)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+// TestUnitReadOnly_Transport checks that modifying go-client calls such as
+// connector activation are blocked while POST-based reads are allowed.
+func TestUnitReadOnly_Transport(t *testing.T) {
+	var calls atomic.Int32
+	c := newHostUnitTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`profile`))
+	}))
+	c.UpdateRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	c = enableReadOnly(c)
+	bound := clientFromMeta(context.Background(), c)
+
+	err := bound.NetworkConnectors.Activate("connector-id")
+	var roErr *readOnlyError
+	require.True(t, errors.As(err, &roErr), "%v", err)
+	assert.Error(t, bound.NetworkConnectors.StartIPsec("connector-id"))
+	assert.Error(t, bound.HostConnectors.Suspend("connector-id"))
+	assert.Zero(t, calls.Load())
+
+	_, err = bound.NetworkConnectors.GetProfile("connector-id")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+// TestUnitReadOnly_ProviderConfigure checks that CLOUDCONNEXA_READ_ONLY
+// enables read-only mode.
+func TestUnitReadOnly_ProviderConfigure(t *testing.T) {
+	server, certPEM := newTLSTokenServer(t)
+
+	c, diags := configureTestProvider(t, server.URL, map[string]interface{}{"ca_cert_pem": certPEM})
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.False(t, isReadOnly(c))
+
+	t.Setenv(ReadOnlyEnvVar, "true")
+	c, diags = configureTestProvider(t, server.URL, map[string]interface{}{"ca_cert_pem": certPEM})
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, isReadOnly(c))
+	assert.False(t, isReadOnly(&cloudconnexa.Client{}))
+}
+
+// diagsErr converts error diagnostics to an error for table-driven tests.
+func diagsErr(diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+	return fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+}
@@ -0,0 +1,121 @@
+package cloudconnexa
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// redactedValue replaces every secret in log output.
+const redactedValue = "***REDACTED***"
+
+// sensitiveKeys lists JSON keys whose values must never be logged. Keys are
+// compared after normalizeKey, so `pre_shared_key`, `preSharedKey` and
+// `PreSharedKey` all match.
+var sensitiveKeys = map[string]struct{}{
+	"accesstoken":                  {},
+	"refreshtoken":                 {},
+	"idtoken":                      {},
+	"token":                        {},
+	"profile":                      {},
+	"clientsecret":                 {},
+	"password":                     {},
+	"presharedkey":                 {},
+	"privatekey":                   {},
+	"peercertificateprivatekey":    {},
+	"peercertificatekeypassphrase": {},
+}
+
+// sensitiveHeaders lists HTTP headers whose values must never be logged.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// secretPathSuffixes identifies endpoints whose plain-text response body is a
+// secret as a whole: connector profiles and tokens, and the OAuth token.
+var secretPathSuffixes = []string{"/profile", "/profile/encrypt", "/oauth/token"}
+
+// privateKeyPattern matches PEM encoded private keys anywhere in a string.
+var privateKeyPattern = regexp.MustCompile(`-----BEGIN ([A-Z ]*)PRIVATE KEY-----[\s\S]*?-----END ([A-Z ]*)PRIVATE KEY-----`)
+
+// normalizeKey lower-cases a JSON key and drops `_` and `-` separators.
+func normalizeKey(k string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(k))
+}
+
+// isSensitiveKey reports whether values stored under key must be redacted.
+func isSensitiveKey(key string) bool {
+	_, ok := sensitiveKeys[normalizeKey(key)]
+	return ok
+}
+
+// redactBody returns a copy of an API request or response body that is safe
+// to log. Bodies of secret endpoints are replaced entirely, JSON documents
+// have sensitive keys masked at any depth, and PEM private keys are masked in
+// any other content.
+//
+// Parameters:
+//   - path: The URL path of the request the body belongs to
+//   - body: The raw body
+//
+// Returns:
+//   - string: The redacted body
+func redactBody(path string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	for _, suffix := range secretPathSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return fmt.Sprintf("%s (%d bytes)", redactedValue, len(body))
+		}
+	}
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err == nil {
+		if out, err := json.Marshal(redactJSON(doc)); err == nil {
+			return string(out)
+		}
+	}
+	return redactString(string(body))
+}
+
+// redactJSON masks sensitive keys in a decoded JSON value, recursively.
+func redactJSON(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if isSensitiveKey(k) {
+				if s, ok := val.(string); !ok || s != "" {
+					t[k] = redactedValue
+				}
+				continue
+			}
+			t[k] = redactJSON(val)
+		}
+		return t
+	case []interface{}:
+		for i := range t {
+			t[i] = redactJSON(t[i])
+		}
+		return t
+	case string:
+		return redactString(t)
+	default:
+		return v
+	}
+}
+
+// redactString masks PEM private keys embedded in s.
+func redactString(s string) string {
+	return privateKeyPattern.ReplaceAllString(s, redactedValue)
+}
+
+// redactHeaders returns a copy of h with credential-bearing headers masked.
+func redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range sensitiveHeaders {
+		if out.Get(name) != "" {
+			out.Set(name, redactedValue)
+		}
+	}
+	return out
+}
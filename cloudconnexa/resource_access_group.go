@@ -50,6 +50,7 @@ func resourceAccessGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -143,7 +144,7 @@ func resourceDestination() *schema.Resource {
 
 // resourceAccessGroupCreate creates a new access group in CloudConnexa
 func resourceAccessGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	request := resourceDataToAccessGroup(d)
 	accessGroup, err := c.AccessGroups.Create(request)
@@ -156,10 +157,14 @@ func resourceAccessGroupCreate(ctx context.Context, d *schema.ResourceData, m in
 
 // resourceAccessGroupRead retrieves an access group from CloudConnexa
 func resourceAccessGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Id()
 	ag, err := c.AccessGroups.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, d, "access group")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get access group with ID: %s, %s", id, err)...)
 	}
@@ -173,7 +178,7 @@ func resourceAccessGroupRead(ctx context.Context, d *schema.ResourceData, m inte
 
 // resourceAccessGroupUpdate updates an existing access group in CloudConnexa
 func resourceAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	ag := resourceDataToAccessGroup(d)
 	savedAccessGroup, err := c.AccessGroups.Update(d.Id(), ag)
@@ -186,11 +191,11 @@ func resourceAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 // resourceAccessGroupDelete removes an access group from CloudConnexa
 func resourceAccessGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Id()
 	err := c.AccessGroups.Delete(id)
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
@@ -59,9 +59,9 @@ func TestAccCloudConnexaAccessGroup_basic(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaAccessGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaAccessGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaAccessGroupConfigBasic(ag),
@@ -94,9 +94,9 @@ func TestAccCloudConnexaAccessGroup_withChildren(t *testing.T) {
 	var agID string
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaAccessGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaAccessGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaAccessGroupConfigWithChildren(agName, ugName, netName),
@@ -142,9 +142,9 @@ func TestAccCloudConnexaAccessGroup_fullMesh(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaAccessGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaAccessGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaAccessGroupConfigFullMesh(agName),
@@ -24,6 +24,7 @@ func resourceDevice() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDeviceImport,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"user_id": {
 				Type:        schema.TypeString,
@@ -80,7 +81,7 @@ func resourceDevice() *schema.Resource {
 
 // resourceDeviceCreate provisions a new device for the given user.
 func resourceDeviceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 
 	userID := d.Get("user_id").(string)
 	req := cloudconnexa.DeviceCreateRequest{
@@ -100,10 +101,14 @@ func resourceDeviceCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 // resourceDeviceRead refreshes Terraform state from the CloudConnexa API.
 func resourceDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 
 	userID := d.Get("user_id").(string)
 	device, err := c.Devices.GetByID(userID, d.Id())
+	if isNotFoundErr(err) {
+		removeFromState(ctx, d, "device")
+		return nil
+	}
 	if err != nil {
 		return diag.Errorf("Failed to get device with ID %s: %s", d.Id(), err)
 	}
@@ -123,7 +128,7 @@ func resourceDeviceRead(ctx context.Context, d *schema.ResourceData, m interface
 
 // resourceDeviceUpdate pushes name/description changes back to CloudConnexa.
 func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 
 	if d.HasChanges("name", "description") {
 		// Both fields are always sent so omitempty on the SDK struct doesn't blank a value the user kept.
@@ -142,10 +147,10 @@ func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 // resourceDeviceDelete removes the device from CloudConnexa.
 func resourceDeviceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 
 	userID := d.Get("user_id").(string)
-	if err := c.Devices.Delete(userID, d.Id()); err != nil {
+	if err := c.Devices.Delete(userID, d.Id()); err != nil && !isNotFoundErr(err) {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
@@ -30,8 +30,8 @@ func TestAccCloudConnexaDevice_basic(t *testing.T) {
 	desc2 := "second description"
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaDeviceDestroy,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaDeviceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaDeviceConfig(userID, name1, desc1),
@@ -65,15 +65,7 @@ func TestAccCloudConnexaDevice_basic(t *testing.T) {
 // device resource has a parent to attach to. Skips when no users are present.
 func pickTestUserID(t *testing.T) string {
 	t.Helper()
-	c, err := cloudconnexa.NewClient(
-		testBaseURL,
-		os.Getenv(ClientIDEnvVar),
-		os.Getenv(ClientSecretEnvVar),
-	)
-	if err != nil {
-		t.Fatalf("failed to create CloudConnexa client: %s", err)
-	}
-	users, err := c.Users.List()
+	users, err := testAccClient(t).Users.List()
 	if err != nil {
 		t.Fatalf("failed to list users: %s", err)
 	}
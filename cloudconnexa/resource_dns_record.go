@@ -2,243 +2,257 @@ package cloudconnexa
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// resourceDnsRecord returns a Terraform resource for managing DNS records in CloudConnexa.
-// It defines the schema and CRUD operations for DNS record management.
-func resourceDnsRecord() *schema.Resource {
-	return &schema.Resource{
-		Description:   "Use `cloudconnexa_dns_record` to create a DNS record on your VPN.",
-		CreateContext: resourceDnsRecordCreate,
-		ReadContext:   resourceDnsRecordRead,
-		DeleteContext: resourceDnsRecordDelete,
-		UpdateContext: resourceDnsRecordUpdate,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		CustomizeDiff: validateAtLeastOneNonEmptyList,
-		Schema: map[string]*schema.Schema{
-			"domain": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The DNS record name.",
+// dnsRecordResource is the `cloudconnexa_dns_record` resource, which
+// manages DNS records in CloudConnexa. It is served by the framework
+// provider.
+type dnsRecordResource struct {
+	frameworkResource
+}
+
+var (
+	_ resource.ResourceWithConfigure      = (*dnsRecordResource)(nil)
+	_ resource.ResourceWithIdentity       = (*dnsRecordResource)(nil)
+	_ resource.ResourceWithImportState    = (*dnsRecordResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dnsRecordResource)(nil)
+)
+
+// dnsRecordResourceModel is the state of a `cloudconnexa_dns_record`.
+type dnsRecordResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Domain        types.String   `tfsdk:"domain"`
+	Description   types.String   `tfsdk:"description"`
+	IPV4Addresses types.List     `tfsdk:"ip_v4_addresses"`
+	IPV6Addresses types.List     `tfsdk:"ip_v6_addresses"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// newDNSRecordResource returns a Terraform resource for managing DNS
+// records in CloudConnexa.
+//
+// Returns:
+//   - resource.Resource: A Terraform resource definition for DNS records
+func newDNSRecordResource() resource.Resource {
+	return &dnsRecordResource{frameworkResource{typeName: "cloudconnexa_dns_record"}}
+}
+
+// Schema implements resource.Resource. The schema matches that of the
+// former SDKv2 resource, so existing state keeps working.
+func (r *dnsRecordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use `cloudconnexa_dns_record` to create a DNS record on your VPN.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of this resource.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Required:      true,
+				Description:   "The DNS record name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"description": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "Managed by Terraform",
-				ValidateFunc: validation.StringLenBetween(1, 120),
-				Description:  "The description for the UI. Defaults to `Managed by Terraform`.",
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Managed by Terraform"),
+				Validators:          []validator.String{stringvalidator.LengthBetween(1, 120)},
+				MarkdownDescription: "The description for the UI. Defaults to `Managed by Terraform`.",
 			},
-			"ip_v4_addresses": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.IsIPv4Address,
-				},
-				Description:  "The list of IPV4 addresses to which this record will resolve.",
-				AtLeastOneOf: []string{"ip_v4_addresses", "ip_v6_addresses"},
+			"ip_v4_addresses": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.List{listvalidator.ValueStringsAre(ipAddressValidator{version: 4})},
+				Description: "The list of IPV4 addresses to which this record will resolve.",
 			},
-			"ip_v6_addresses": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.IsIPv6Address,
-				},
+			"ip_v6_addresses": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.List{listvalidator.ValueStringsAre(ipAddressValidator{version: 6})},
 				Description: "The list of IPV6 addresses to which this record will resolve.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
-// resourceDnsRecordCreate creates a new DNS record in CloudConnexa.
-// It converts the Terraform resource data into a DNS record and sends it to the API.
-//
-// Parameters:
-//   - ctx: The context for the operation
-//   - d: The Terraform resource data
-//   - m: The provider meta interface
-//
-// Returns:
-//   - diag.Diagnostics: Diagnostics containing any errors that occurred
-func resourceDnsRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
-	var diags diag.Diagnostics
-	domain := d.Get("domain").(string)
-	description := d.Get("description").(string)
-	ipV4Addresses := d.Get("ip_v4_addresses").([]interface{})
-	ipV4AddressesSlice := make([]string, 0)
-	for _, a := range ipV4Addresses {
-		ipV4AddressesSlice = append(ipV4AddressesSlice, a.(string))
-	}
-	ipV6Addresses := d.Get("ip_v6_addresses").([]interface{})
-	ipV6AddressesSlice := make([]string, 0)
-	for _, a := range ipV6Addresses {
-		ipV6AddressesSlice = append(ipV6AddressesSlice, a.(string))
-	}
-	dr := cloudconnexa.DNSRecord{
-		Domain:        domain,
-		Description:   description,
-		IPV4Addresses: ipV4AddressesSlice,
-		IPV6Addresses: ipV6AddressesSlice,
+// ValidateConfig implements resource.ResourceWithValidateConfig. It ensures
+// that at least one of the IP address lists is set and not empty. Lists
+// that aren't known until apply (e.g. referencing another resource's
+// computed attribute) are not checked.
+func (r *dnsRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var v4, v6 types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ip_v4_addresses"), &v4)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ip_v6_addresses"), &v6)...)
+	if resp.Diagnostics.HasError() || v4.IsUnknown() || v6.IsUnknown() {
+		return
+	}
+	if len(v4.Elements()) == 0 && len(v6.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("ip_v4_addresses"), "Missing IP addresses",
+			"either 'ip_v4_addresses' or 'ip_v6_addresses' must contain at least one item")
+	}
+}
+
+// Create implements resource.Resource. It creates the DNS record from the
+// plan.
+func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	timeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := r.writableClientFor(ctx, "create", &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	dr := plan.dnsRecord(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	dnsRecord, err := c.DNSRecords.Create(dr)
 	if err != nil {
-		return append(diags, diag.FromErr(err)...)
+		resp.Diagnostics.AddError("Failed to create DNS record", err.Error())
+		return
 	}
-	d.SetId(dnsRecord.ID)
-	return diags
+	plan.ID = types.StringValue(dnsRecord.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setIdentity(ctx, resp.Identity, dnsRecord.ID, &resp.Diagnostics)
 }
 
-// resourceDnsRecordRead retrieves a DNS record from CloudConnexa and updates the Terraform state.
-//
-// Parameters:
-//   - ctx: The context for the operation
-//   - d: The Terraform resource data
-//   - m: The provider meta interface
-//
-// Returns:
-//   - diag.Diagnostics: Diagnostics containing any errors that occurred
-func resourceDnsRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
-	var diags diag.Diagnostics
-	id := d.Id()
-	r, err := c.DNSRecords.GetByID(id)
+// Read implements resource.Resource. It retrieves the DNS record and
+// updates the state, or removes the record from it if it no longer exists.
+func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	timeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := r.clientFor(ctx, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	id := state.ID.ValueString()
+	record, err := c.DNSRecords.GetByID(id)
+	if isDNSRecordNotFoundErr(err) || (err == nil && record == nil) {
+		removeResourceFromState(ctx, &resp.State, "DNS record", id)
+		return
+	}
 	if err != nil {
-		if isDNSRecordNotFoundErr(err) {
-			d.SetId("")
-			return diags
-		}
-		return append(diags, diag.Errorf("Failed to get DNS record with ID: %s, %s", id, err)...)
-	}
-	if r == nil {
-		d.SetId("")
-	} else {
-		d.Set("domain", r.Domain)
-		d.Set("description", r.Description)
-		d.Set("ip_v4_addresses", r.IPV4Addresses)
-		d.Set("ip_v6_addresses", r.IPV6Addresses)
-	}
-	return diags
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get DNS record with ID: %s", id), err.Error())
+		return
+	}
+	state.Domain = types.StringValue(record.Domain)
+	state.Description = types.StringValue(record.Description)
+	state.IPV4Addresses = stringList(ctx, record.IPV4Addresses, state.IPV4Addresses, &resp.Diagnostics)
+	state.IPV6Addresses = stringList(ctx, record.IPV6Addresses, state.IPV6Addresses, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	setIdentity(ctx, resp.Identity, id, &resp.Diagnostics)
 }
 
-// resourceDnsRecordUpdate updates an existing DNS record in CloudConnexa.
-//
-// Parameters:
-//   - ctx: The context for the operation
-//   - d: The Terraform resource data
-//   - m: The provider meta interface
-//
-// Returns:
-//   - diag.Diagnostics: Diagnostics containing any errors that occurred
-func resourceDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
-	var diags diag.Diagnostics
-	_, domain := d.GetChange("domain")
-	_, description := d.GetChange("description")
-	_, ipV4Addresses := d.GetChange("ip_v4_addresses")
-	ipV4AddressesSlice := getAddressesSlice(ipV4Addresses.([]interface{}))
-	_, ipV6Addresses := d.GetChange("ip_v6_addresses")
-	ipV6AddressesSlice := getAddressesSlice(ipV6Addresses.([]interface{}))
-	dr := cloudconnexa.DNSRecord{
-		ID:            d.Id(),
-		Domain:        domain.(string),
-		Description:   description.(string),
-		IPV4Addresses: ipV4AddressesSlice,
-		IPV6Addresses: ipV6AddressesSlice,
-	}
-	err := c.DNSRecords.Update(dr)
-	if err != nil {
-		return append(diags, diag.FromErr(err)...)
+// Update implements resource.Resource. It updates the DNS record from the
+// plan.
+func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dnsRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	timeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := r.writableClientFor(ctx, "update", &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	plan.ID = state.ID
+	dr := plan.dnsRecord(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return diags
+	if err := c.DNSRecords.Update(dr); err != nil {
+		resp.Diagnostics.AddError("Failed to update DNS record", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-// resourceDnsRecordDelete removes a DNS record from CloudConnexa.
-//
-// Parameters:
-//   - ctx: The context for the operation
-//   - d: The Terraform resource data
-//   - m: The provider meta interface
-//
-// Returns:
-//   - diag.Diagnostics: Diagnostics containing any errors that occurred
-func resourceDnsRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
-	var diags diag.Diagnostics
-	recordId := d.Id()
-	err := c.DNSRecords.Delete(recordId)
+// Delete implements resource.Resource. A DNS record that no longer exists
+// is considered deleted.
+func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	timeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := r.writableClientFor(ctx, "delete", &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	err := c.DNSRecords.Delete(state.ID.ValueString())
 	if err != nil && !isDNSRecordNotFoundErr(err) {
-		return append(diags, diag.FromErr(err)...)
+		resp.Diagnostics.AddError("Failed to delete DNS record", err.Error())
+	}
+}
+
+// dnsRecord returns the DNS record described by m.
+func (m dnsRecordResourceModel) dnsRecord(ctx context.Context, diags *fwdiag.Diagnostics) cloudconnexa.DNSRecord {
+	return cloudconnexa.DNSRecord{
+		ID:            m.ID.ValueString(),
+		Domain:        m.Domain.ValueString(),
+		Description:   m.Description.ValueString(),
+		IPV4Addresses: listStrings(ctx, m.IPV4Addresses, diags),
+		IPV6Addresses: listStrings(ctx, m.IPV6Addresses, diags),
 	}
-	return diags
 }
 
 // isDNSRecordNotFoundErr reports whether the given error indicates that the DNS
-// record no longer exists. The API returns 400 with a "not found" message rather
-// than 404, so we match on the error string.
+// record no longer exists. Besides the cases of isNotFoundErr, the API may
+// return 400 with a "not found" message rather than 404, so we also match on
+// the error string.
 func isDNSRecordNotFoundErr(err error) bool {
 	if err == nil {
 		return false
 	}
-	if errors.Is(err, cloudconnexa.ErrDNSRecordNotFound) {
+	if isNotFoundErr(err) {
 		return true
 	}
 	return strings.Contains(err.Error(), "not found")
 }
-
-// getAddressesSlice converts a slice of interface{} to a slice of strings.
-//
-// Parameters:
-//   - addresses: A slice of interface{} containing address strings
-//
-// Returns:
-//   - []string: A slice of string addresses
-func getAddressesSlice(addresses []interface{}) []string {
-	addressesSlice := make([]string, 0)
-	for _, a := range addresses {
-		addressesSlice = append(addressesSlice, a.(string))
-	}
-	return addressesSlice
-}
-
-// validateAtLeastOneNonEmptyList ensures that at least one of the IP address lists is not empty.
-//
-// Parameters:
-//   - c: The context for the operation
-//   - diff: The Terraform resource diff
-//   - i: The provider meta interface
-//
-// Returns:
-//   - error: An error if both IP address lists are empty
-func validateAtLeastOneNonEmptyList(c context.Context, diff *schema.ResourceDiff, i interface{}) error {
-	// Skip when either list contains values that aren't known until apply
-	// (e.g. an element referencing another resource's computed attribute).
-	// IsWhollyKnown catches unknowns nested inside the list, not just the list itself.
-	rawConfig := diff.GetRawConfig()
-	if !rawConfig.IsNull() && rawConfig.IsKnown() {
-		v4 := rawConfig.GetAttr("ip_v4_addresses")
-		v6 := rawConfig.GetAttr("ip_v6_addresses")
-		if !v4.IsWhollyKnown() || !v6.IsWhollyKnown() {
-			return nil
-		}
-	}
-	listA := diff.Get("ip_v4_addresses").([]interface{})
-	listB := diff.Get("ip_v6_addresses").([]interface{})
-	if len(listA) == 0 && len(listB) == 0 {
-		return fmt.Errorf("either 'ip_v4_addresses' or 'ip_v6_addresses' must contain at least one item")
-	}
-	return nil
-}
@@ -19,9 +19,9 @@ func TestAccCloudConnexaDnsRecord_basic(t *testing.T) {
 	resourceName := "cloudconnexa_dns_record.test"
 	domainName := "test.cloudconnexa.com"
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaDnsRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaDnsRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaDnsRecordConfig(domainName),
@@ -21,6 +21,7 @@ func resourceHost() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -83,7 +84,7 @@ func gatewaysIdsFromResource(d *schema.ResourceData) []string {
 
 // resourceHostCreate creates a new CloudConnexa host
 func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	h := cloudconnexa.Host{
 		Name:           d.Get("name").(string),
@@ -103,10 +104,14 @@ func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 // resourceHostRead retrieves information about an existing CloudConnexa host
 func resourceHostRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Id()
 	host, err := c.Hosts.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, d, "host")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get host with ID: %s, %s", id, err)...)
 	}
@@ -122,7 +127,7 @@ func resourceHostRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 // resourceHostUpdate updates an existing CloudConnexa host
 func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	_, newName := d.GetChange("name")
 	_, newDescription := d.GetChange("description")
@@ -144,11 +149,11 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 // resourceHostDelete removes an existing CloudConnexa host
 func resourceHostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	hostId := d.Id()
 	err := c.Hosts.Delete(hostId)
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
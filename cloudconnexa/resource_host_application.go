@@ -21,6 +21,7 @@ func resourceHostApplication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -60,7 +61,7 @@ func resourceHostApplication() *schema.Resource {
 
 // resourceHostApplicationUpdate updates an existing host application
 func resourceHostApplicationUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 
 	_, err := c.HostApplications.Update(data.Id(), resourceDataToHostApplication(data))
 	if err != nil {
@@ -137,10 +138,14 @@ func customApplicationTypesConfig() map[string]*schema.Schema {
 
 // resourceHostApplicationRead reads the state of a host application
 func resourceHostApplicationRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	id := data.Id()
 	application, err := c.HostApplications.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, data, "host application")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get host application with ID: %s, %s", id, err)...)
 	}
@@ -164,10 +169,10 @@ func setApplicationData(data *schema.ResourceData, application *cloudconnexa.App
 
 // resourceHostApplicationDelete deletes a host application
 func resourceHostApplicationDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	err := c.HostApplications.Delete(data.Id())
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
@@ -218,7 +223,7 @@ func flattenApplicationRoutes(routes []*cloudconnexa.Route) []map[string]interfa
 
 // resourceHostApplicationCreate creates a new host application
 func resourceHostApplicationCreate(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*cloudconnexa.Client)
+	client := clientFromMeta(ctx, m)
 
 	application := resourceDataToHostApplication(data)
 	createdApplication, err := client.HostApplications.Create(application)
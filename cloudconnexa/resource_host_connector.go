@@ -2,6 +2,7 @@ package cloudconnexa
 
 import (
 	"context"
+	"maps"
 
 	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
 
@@ -13,7 +14,7 @@ import (
 // resourceHostConnector returns a Terraform resource schema for managing CloudConnexa host connectors.
 // It defines the CRUD operations and schema for connector configuration.
 func resourceHostConnector() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Description:   "Use `cloudconnexa_connector` to create an CloudConnexa connector.\n\n~> NOTE: This only creates the CloudConnexa connector object. Additional manual steps are required to associate a host in your infrastructure with the connector. Go to https://openvpn.net/cloud-docs/connector/ for more information.",
 		CreateContext: resourceHostConnectorCreate,
 		ReadContext:   resourceHostConnectorRead,
@@ -22,6 +23,7 @@ func resourceHostConnector() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -56,17 +58,23 @@ func resourceHostConnector() *schema.Resource {
 				Computed:    true,
 				Description: "The IPV6 address of the connector.",
 			},
+			"omit_credentials": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, `token` and `profile` are not fetched and are left empty in state. Use the `cloudconnexa_host_connector_credentials` ephemeral resource to obtain them without persisting them. Defaults to `false`.",
+			},
 			"profile": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
-				Description: "OpenVPN profile of the connector.",
+				Description: "OpenVPN profile of the connector. Empty when `omit_credentials` is `true`.",
 			},
 			"token": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
-				Description: "Connector token.",
+				Description: "Connector token. Empty when `omit_credentials` is `true`.",
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -82,12 +90,14 @@ func resourceHostConnector() *schema.Resource {
 			},
 		},
 	}
+	maps.Copy(r.Schema, connectorWaitSchema())
+	return r
 }
 
 // resourceHostConnectorUpdate updates an existing CloudConnexa host connector with new configuration.
 // It handles updating the connector's name, description, VPN region, and status.
 func resourceHostConnectorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	// Handle status change (suspend/activate)
@@ -119,13 +129,17 @@ func resourceHostConnectorUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if _, err := waitForConnectorStatus(ctx, d, m, hostConnectorStatus); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
 	return resourceHostConnectorRead(ctx, d, m)
 }
 
 // resourceHostConnectorCreate creates a new CloudConnexa host connector.
 // It initializes the connector with the specified configuration and returns a warning about manual setup requirements.
 func resourceHostConnectorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -143,16 +157,18 @@ func resourceHostConnectorCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	d.SetId(conn.ID)
-	profile, err := c.HostConnectors.GetProfile(conn.ID)
-	if err != nil {
+	if err := setHostConnectorCredentials(c, d); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
-	d.Set("profile", profile)
-	token, err := c.HostConnectors.GetToken(conn.ID)
+	connectionStatus, err := waitForConnectorStatus(ctx, d, m, hostConnectorStatus)
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
-	d.Set("token", token)
+	if connectionStatus != "" {
+		d.Set("connection_status", connectionStatus)
+		return diags
+	}
+
 	return append(diags, diag.Diagnostic{
 		Severity: diag.Warning,
 		Summary:  "Connector needs to be set up manually",
@@ -163,18 +179,17 @@ func resourceHostConnectorCreate(ctx context.Context, d *schema.ResourceData, m
 // resourceHostConnectorRead retrieves the current state of a CloudConnexa host connector.
 // It fetches the connector's configuration, profile, and token information.
 func resourceHostConnectorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Id()
 	connector, err := c.HostConnectors.GetByID(id)
-	if err != nil {
-		return append(diags, diag.Errorf("Failed to get host connector with ID: %s, %s", id, err)...)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, d, "host connector")
+		return diags
 	}
-	token, err := c.HostConnectors.GetToken(d.Id())
 	if err != nil {
-		return append(diags, diag.FromErr(err)...)
+		return append(diags, diag.Errorf("Failed to get host connector with ID: %s, %s", id, err)...)
 	}
-
 	if connector == nil {
 		d.SetId("")
 	} else {
@@ -186,23 +201,41 @@ func resourceHostConnectorRead(ctx context.Context, d *schema.ResourceData, m in
 		d.Set("ip_v4_address", connector.IPv4Address)
 		d.Set("ip_v6_address", connector.IPv6Address)
 		d.Set("connection_status", connector.ConnectionStatus)
-		d.Set("token", token)
-		profile, err := c.HostConnectors.GetProfile(connector.ID)
-		if err != nil {
+		if err := setHostConnectorCredentials(c, d); err != nil {
 			return append(diags, diag.FromErr(err)...)
 		}
-		d.Set("profile", profile)
 	}
 	return diags
 }
 
+// setHostConnectorCredentials stores the connector token and profile in d,
+// or clears them when `omit_credentials` is set.
+func setHostConnectorCredentials(c *cloudconnexa.Client, d *schema.ResourceData) error {
+	if d.Get("omit_credentials").(bool) {
+		d.Set("token", "")
+		d.Set("profile", "")
+		return nil
+	}
+	token, err := c.HostConnectors.GetToken(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("token", token)
+	profile, err := c.HostConnectors.GetProfile(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("profile", profile)
+	return nil
+}
+
 // resourceHostConnectorDelete removes a CloudConnexa host connector.
 // It deletes the connector and its associated host configuration.
 func resourceHostConnectorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	err := c.HostConnectors.Delete(d.Id(), d.Get("host_id").(string))
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
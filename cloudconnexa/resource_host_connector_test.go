@@ -22,9 +22,9 @@ func TestAccCloudConnexaConnector_basic(t *testing.T) {
 	resourceName := "cloudconnexa_host_connector.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaConnectorDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaConnectorDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaConnectorConfigBasic(rName),
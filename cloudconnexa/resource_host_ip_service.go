@@ -21,6 +21,7 @@ func resourceHostIPService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -59,7 +60,7 @@ func resourceHostIPService() *schema.Resource {
 
 // resourceHostIpServiceUpdate updates an existing host IP service
 func resourceHostIpServiceUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 
 	_, err := c.HostIPServices.Update(data.Id(), resourceDataToHostIpService(data))
 	if err != nil {
@@ -120,10 +121,14 @@ func customHostServiceTypesConfig() map[string]*schema.Schema {
 
 // resourceHostIpServiceRead reads the state of a host IP service
 func resourceHostIpServiceRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	id := data.Id()
 	service, err := c.HostIPServices.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, data, "host IP service")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get host IP service with ID: %s, %s", id, err)...)
 	}
@@ -148,10 +153,10 @@ func setHostIpServiceResourceData(data *schema.ResourceData, service *cloudconne
 
 // resourceHostIpServiceDelete deletes a host IP service
 func resourceHostIpServiceDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	err := c.HostIPServices.Delete(data.Id())
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
@@ -190,7 +195,7 @@ func flattenCustomHostServiceTypes(types []*cloudconnexa.CustomIPServiceType) in
 
 // resourceHostIpServiceCreate creates a new host IP service
 func resourceHostIpServiceCreate(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*cloudconnexa.Client)
+	client := clientFromMeta(ctx, m)
 
 	service := resourceDataToHostIpService(data)
 	createdService, err := client.HostIPServices.Create(service)
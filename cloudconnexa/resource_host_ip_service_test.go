@@ -33,9 +33,9 @@ func TestAccCloudConnexaService_basic(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaServiceConfig(service, hostName),
@@ -28,9 +28,9 @@ func TestAccCloudConnexaHost_basic(t *testing.T) {
 	name2 := "tf-acc-" + acctest.RandStringFromCharSet(10, alphabet)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaHostDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaHostDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaHostConfigBasic(name1, "first description", "host1.example.com", "SPLIT_TUNNEL_ON"),
@@ -0,0 +1,60 @@
+package cloudconnexa
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// identityIDAttribute is the identity attribute of resources identified by
+// their CloudConnexa ID.
+const identityIDAttribute = "id"
+
+// withIDIdentity gives r a resource identity consisting of its ID, which
+// Terraform 1.12+ uses for identity-based import and requires for list
+// resource results. The identity is set after every successful create, read
+// and update. r must use ImportStatePassthroughContext, which is replaced by
+// its identity-aware variant.
+//
+// Parameters:
+//   - r: The resource to extend
+func withIDIdentity(r *schema.Resource) {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				identityIDAttribute: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "The ID of the resource.",
+				},
+			}
+		},
+	}
+	r.Importer = &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughWithIdentity(identityIDAttribute),
+	}
+
+	setIdentity := func(fn schema.CreateContextFunc) schema.CreateContextFunc {
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			diags := fn(ctx, d, m)
+			if diags.HasError() || d.Id() == "" {
+				return diags
+			}
+			// ResourceData built without the identity schema, e.g. by
+			// schema.TestResourceDataRaw, has no identity to set.
+			if identity, err := d.Identity(); err == nil {
+				if err := identity.Set(identityIDAttribute, d.Id()); err != nil {
+					return append(diags, diag.FromErr(err)...)
+				}
+			}
+			return diags
+		}
+	}
+	r.CreateContext = setIdentity(r.CreateContext)
+	r.ReadContext = schema.ReadContextFunc(setIdentity(schema.CreateContextFunc(r.ReadContext)))
+	r.UpdateContext = schema.UpdateContextFunc(setIdentity(schema.CreateContextFunc(r.UpdateContext)))
+}
@@ -22,6 +22,7 @@ func resourceLocationContext() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -132,7 +133,7 @@ func defaultCheckConfig() *schema.Resource {
 
 // resourceLocationContextCreate creates a new Location Context in CloudConnexa.
 func resourceLocationContextCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	dr := resourceDataToLocationContext(d)
 	response, err := c.LocationContexts.Create(dr)
@@ -145,10 +146,14 @@ func resourceLocationContextCreate(ctx context.Context, d *schema.ResourceData,
 
 // resourceLocationContextRead retrieves a Location Context from CloudConnexa.
 func resourceLocationContextRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Id()
 	lc, err := c.LocationContexts.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, d, "location context")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get location context with ID: %s, %s", id, err)...)
 	}
@@ -162,7 +167,7 @@ func resourceLocationContextRead(ctx context.Context, d *schema.ResourceData, m
 
 // resourceLocationContextUpdate updates an existing Location Context in CloudConnexa.
 func resourceLocationContextUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	lc := resourceDataToLocationContext(d)
 	_, err := c.LocationContexts.Update(d.Id(), lc)
@@ -174,11 +179,11 @@ func resourceLocationContextUpdate(ctx context.Context, d *schema.ResourceData,
 
 // resourceLocationContextDelete removes a Location Context from CloudConnexa.
 func resourceLocationContextDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	routeId := d.Id()
 	err := c.LocationContexts.Delete(routeId)
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
@@ -21,6 +21,7 @@ func resourceNetwork() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -76,7 +77,7 @@ func resourceNetwork() *schema.Resource {
 
 // resourceNetworkCreate creates a new network
 func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	n := cloudconnexa.Network{
 		Name:              d.Get("name").(string),
@@ -96,10 +97,14 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 // resourceNetworkRead reads the state of a network
 func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Id()
 	network, err := c.Networks.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, d, "network")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get network with ID: %s, %s", id, err)...)
 	}
@@ -119,7 +124,7 @@ func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 // resourceNetworkUpdate updates an existing network
 func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	_, newName := d.GetChange("name")
@@ -144,11 +149,11 @@ func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 // resourceNetworkDelete deletes a network
 func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	networkId := d.Id()
 	err := c.Networks.Delete(networkId)
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
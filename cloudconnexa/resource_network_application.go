@@ -21,6 +21,7 @@ func resourceNetworkApplication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -60,7 +61,7 @@ func resourceNetworkApplication() *schema.Resource {
 
 // resourceNetworkApplicationUpdate handles updates to an existing network application
 func resourceNetworkApplicationUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 
 	_, err := c.NetworkApplications.Update(data.Id(), resourceDataToNetworkApplication(data))
 	if err != nil {
@@ -122,10 +123,14 @@ func resourceNetworkApplicationConfig() *schema.Resource {
 
 // resourceNetworkApplicationRead retrieves and sets the state of a network application
 func resourceNetworkApplicationRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	id := data.Id()
 	application, err := c.NetworkApplications.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, data, "network application")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get network application with ID: %s, %s", id, err)...)
 	}
@@ -139,10 +144,10 @@ func resourceNetworkApplicationRead(ctx context.Context, data *schema.ResourceDa
 
 // resourceNetworkApplicationDelete handles the deletion of a network application
 func resourceNetworkApplicationDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	err := c.NetworkApplications.Delete(data.Id())
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
@@ -150,7 +155,7 @@ func resourceNetworkApplicationDelete(ctx context.Context, data *schema.Resource
 
 // resourceNetworkApplicationCreate handles the creation of a new network application
 func resourceNetworkApplicationCreate(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*cloudconnexa.Client)
+	client := clientFromMeta(ctx, m)
 
 	application := resourceDataToNetworkApplication(data)
 	createdApplication, err := client.NetworkApplications.Create(application)
@@ -2,17 +2,21 @@ package cloudconnexa
 
 import (
 	"context"
+	"maps"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // resourceNetworkConnector returns a Terraform resource schema for managing network connectors
 func resourceNetworkConnector() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Description:   "Use `cloudconnexa_connector` to create an CloudConnexa connector.\n\n~> NOTE: This only creates the CloudConnexa connector object. Additional manual steps are required to associate a host in your infrastructure with the connector. Go to https://openvpn.net/cloud-docs/connector/ for more information.",
 		CreateContext: resourceNetworkConnectorCreate,
 		ReadContext:   resourceNetworkConnectorRead,
@@ -21,6 +25,16 @@ func resourceNetworkConnector() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			validateIPsecConfig,
+			// remote_peer_config is rendered from ipsec_config and the
+			// connector name, so it is only known after applying changes to
+			// either.
+			customdiff.ComputedIf("remote_peer_config", func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+				return d.HasChanges("ipsec_config", "name")
+			}),
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -55,23 +69,34 @@ func resourceNetworkConnector() *schema.Resource {
 				Computed:    true,
 				Description: "The IPV6 address of the connector.",
 			},
+			"omit_credentials": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, `token` and `profile` are not fetched and are left empty in state. Use the `cloudconnexa_network_connector_credentials` ephemeral resource to obtain them without persisting them. Defaults to `false`.",
+			},
 			"profile": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
-				Description: "OpenVPN profile of the connector.",
+				Description: "OpenVPN profile of the connector. Empty when `omit_credentials` is `true`.",
 			},
 			"token": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
-				Description: "Connector token.",
+				Description: "Connector token. Empty when `omit_credentials` is `true`.",
 			},
 			"ipsec_config": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
-				Elem:     ipSecConfigSchema(),
+				Elem:     ipSecConfigResourceSchema(),
+				Description: "IPsec tunnel settings. The IKE settings left unset default to a preset for `platform`: IKEv2 with AES256, SHA2_256 and G_14 in both phases, " +
+					"a 540 second rekey margin with 100% fuzz, a replay window of 1024, RESTART on dead peers and START as startup action, with lifetimes and dead peer detection timeout of " +
+					"28800/3600/30 seconds for AWS, 28800/27000/45 for AZURE, 36000/10800/30 for GCP, 86400/3600/30 for CISCO and 28800/3600/30 for OTHER. " +
+					"Plans are rejected when `pre_shared_key` or the certificates required by `authentication_type` are missing, when IKE_V1 is combined with GCM in phase 1, " +
+					"or when the lifetimes, `margin_time_sec` and `fuzz_percent` do not leave room to rekey.",
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -80,13 +105,34 @@ func resourceNetworkConnector() *schema.Resource {
 				Description:  "The status of the connector. Valid values are `ACTIVE` or `SUSPENDED`. When set to `SUSPENDED`, the connector will be suspended. Note: This is a write-only field - the API does not return connector status.",
 				ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "SUSPENDED"}, false),
 			},
+			"ipsec_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ipsecStateStarted,
+				ValidateFunc: validation.StringInSlice([]string{ipsecStateStarted, ipsecStateStopped}, false),
+				Description: "The desired state of the IPsec tunnel. Valid values are `STARTED` and `STOPPED`; set `STOPPED` to keep `ipsec_config` while the tunnel is down. " +
+					"The tunnel state reported by the API is read back, so a tunnel stopped or started outside Terraform shows up as a change; any state other than `STOPPED` reads as `STARTED`. " +
+					"Editing `ipsec_config` does not restart the tunnel; use the `cloudconnexa_connector_restart_ipsec` action for that. Ignored without `ipsec_config`. Defaults to `STARTED`.",
+			},
 			"connection_status": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The connection status of the connector.",
 			},
+			"remote_peer_config": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				Description: "Configuration for the remote end of the IPsec tunnel, matching `ipsec_config`, by platform: `strongswan` (swanctl.conf), `libreswan` (ipsec.conf), " +
+					"`libreswan_secrets` (ipsec.secrets), `cisco_ios` (crypto map configuration) and `aws` (JSON with the tunnel options of an `aws_vpn_connection`). " +
+					"Values a platform does not support are left out with a warning. The pre-shared key is a placeholder when it is managed through `pre_shared_key_wo`, " +
+					"and so is the CloudConnexa server address until it is assigned. Empty without `ipsec_config`. Known after apply when `ipsec_config` or `name` change.",
+			},
 		},
 	}
+	maps.Copy(r.Schema, connectorWaitSchema())
+	return r
 }
 
 // ipSecConfigSchema returns a Terraform resource schema for managing ipsec config
@@ -242,9 +288,46 @@ func ipSecConfigSchema() *schema.Resource {
 	}
 }
 
+// ipSecWriteOnlySecrets are the IPsec secrets that have a write-only variant
+// `<name>_wo` with a `<name>_wo_version` trigger in the resource schema.
+var ipSecWriteOnlySecrets = []string{"pre_shared_key", "peer_certificate_private_key", "peer_certificate_key_passphrase"}
+
+// ipSecConfigResourceSchema extends ipSecConfigSchema with the write-only
+// variants of the IPsec secrets, which data sources cannot have, and makes
+// the IKE settings optional, defaulting to the preset of the platform.
+func ipSecConfigResourceSchema() *schema.Resource {
+	r := ipSecConfigSchema()
+	for _, name := range ipSecPresetAttributes {
+		r.Schema[name].Required = false
+		r.Schema[name].Optional = true
+		r.Schema[name].Computed = true
+		r.Schema[name].Description = "Defaults to the preset of `platform` when the block is created."
+	}
+	for _, secret := range ipSecWriteOnlySecrets {
+		r.Schema[secret].ConflictsWith = []string{"ipsec_config.0." + secret + "_wo"}
+		r.Schema[secret+"_wo"] = &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			WriteOnly:     true,
+			ConflictsWith: []string{"ipsec_config.0." + secret},
+			RequiredWith:  []string{"ipsec_config.0." + secret + "_wo_version"},
+			Description:   "Write-only alternative to `" + secret + "` that is never stored in plan or state. Requires `" + secret + "_wo_version`.",
+		}
+		r.Schema[secret+"_wo_version"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"ipsec_config.0." + secret + "_wo"},
+			Description:  "Version of `" + secret + "_wo`. Change it to send a new value to the API.",
+		}
+	}
+	return r
+}
+
 // resourceNetworkConnectorUpdate updates an existing network connector
 func resourceNetworkConnectorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	// Handle status change (suspend/activate)
@@ -269,20 +352,27 @@ func resourceNetworkConnectorUpdate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return append(diags, diag.FromErr(err)...)
 		}
-		if connector.IPSecConfig != nil {
-			err := c.NetworkConnectors.StartIPsec(connector.ID)
-			if err != nil {
-				return append(diags, diag.FromErr(err)...)
-			}
+	}
+
+	// Start or stop the tunnel only when asked to, or when the IPsec
+	// configuration is added, so that unrelated edits leave it alone.
+	oldIPsec, newIPsec := d.GetChange("ipsec_config")
+	if len(newIPsec.([]interface{})) > 0 && (d.HasChange("ipsec_state") || len(oldIPsec.([]interface{})) == 0) {
+		if err := setIPsecState(c, d.Id(), d.Get("ipsec_state").(string)); err != nil {
+			return append(diags, diag.FromErr(err)...)
 		}
 	}
 
+	if _, err := waitForConnectorStatus(ctx, d, m, networkConnectorStatus); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
 	return resourceNetworkConnectorRead(ctx, d, m)
 }
 
 // resourceNetworkConnectorCreate creates a new network connector
 func resourceNetworkConnectorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	connector := resourceDataToNetworkConnector(d)
 	conn, err := c.NetworkConnectors.Create(connector, connector.NetworkItemID)
@@ -290,65 +380,133 @@ func resourceNetworkConnectorCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	d.SetId(conn.ID)
-	if conn.TunnelingProtocol == "OPENVPN" {
-		profile, err := c.NetworkConnectors.GetProfile(conn.ID)
-		if err != nil {
-			return append(diags, diag.FromErr(err)...)
-		}
-		d.Set("profile", profile)
-		token, err := c.NetworkConnectors.GetToken(conn.ID)
-		if err != nil {
+
+	if conn.IPSecConfig != nil && d.Get("ipsec_state").(string) == ipsecStateStarted {
+		if err := setIPsecState(c, conn.ID, ipsecStateStarted); err != nil {
 			return append(diags, diag.FromErr(err)...)
 		}
-		d.Set("token", token)
 	}
 
-	if conn.IPSecConfig != nil {
-		err := c.NetworkConnectors.StartIPsec(conn.ID)
-		if err != nil {
-			return append(diags, diag.FromErr(err)...)
-		}
+	connectionStatus, err := waitForConnectorStatus(ctx, d, m, networkConnectorStatus)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if connectionStatus == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Connector needs to be set up manually",
+			Detail:   "Terraform only creates the CloudConnexa connector object, but additional manual steps are required to associate a host in your infrastructure with this connector. Go to https://openvpn.net/cloud-docs/connector/ for more information.",
+		})
 	}
 
-	return append(diags, diag.Diagnostic{
-		Severity: diag.Warning,
-		Summary:  "Connector needs to be set up manually",
-		Detail:   "Terraform only creates the CloudConnexa connector object, but additional manual steps are required to associate a host in your infrastructure with this connector. Go to https://openvpn.net/cloud-docs/connector/ for more information.",
-	})
+	// Read back the connector so that values preset by the API, such as the
+	// IPsec lifetimes, are in state right after apply.
+	return append(diags, resourceNetworkConnectorRead(ctx, d, m)...)
 }
 
 // resourceNetworkConnectorRead reads the state of a network connector
 func resourceNetworkConnectorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Id()
 	connector, err := c.NetworkConnectors.GetByID(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, d, "network connector")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get network connector with ID: %s, %s", id, err)...)
 	}
 	setNetworkConnectorData(d, connector)
+	if connector.IPSecConfig != nil && connector.IPSecConfig.ConnectorState != "" {
+		d.Set("ipsec_state", ipsecStateFromAPI(ctx, connector.IPSecConfig.ConnectorState))
+	}
+	if err := setRemotePeerConfig(d, connector); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
 
 	if connector.TunnelingProtocol == "OPENVPN" {
-		token, err := c.NetworkConnectors.GetToken(connector.ID)
-		if err != nil {
+		if err := setNetworkConnectorCredentials(c, d); err != nil {
 			return append(diags, diag.FromErr(err)...)
 		}
-		d.Set("token", token)
-		profile, err := c.NetworkConnectors.GetProfile(connector.ID)
-		if err != nil {
-			return append(diags, diag.FromErr(err)...)
-		}
-		d.Set("profile", profile)
 	}
 	return diags
 }
 
+// setNetworkConnectorCredentials stores the connector token and profile in
+// d, or clears them when `omit_credentials` is set.
+func setNetworkConnectorCredentials(c *cloudconnexa.Client, d *schema.ResourceData) error {
+	if d.Get("omit_credentials").(bool) {
+		d.Set("token", "")
+		d.Set("profile", "")
+		return nil
+	}
+	token, err := c.NetworkConnectors.GetToken(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("token", token)
+	profile, err := c.NetworkConnectors.GetProfile(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("profile", profile)
+	return nil
+}
+
+// IPsec tunnel states of the `ipsec_state` argument, as reported in
+// `ipSecConfig.connectorState`.
+const (
+	ipsecStateStarted = "STARTED"
+	ipsecStateStopped = "STOPPED"
+)
+
+// ipsecStateFromAPI maps the `ipSecConfig.connectorState` reported by the
+// API to an `ipsec_state` value. The API reports STARTED and STOPPED, as set
+// by the ipsec/start and ipsec/stop endpoints, but the go-client does not
+// enumerate the values and its tests use ACTIVE. Since only STOPPED is known
+// to mean the tunnel is down, any other value is read as STARTED and logged.
+func ipsecStateFromAPI(ctx context.Context, state string) string {
+	switch state {
+	case ipsecStateStarted, ipsecStateStopped:
+		return state
+	}
+	tflog.Warn(ctx, "Unknown IPsec connector state, reading it as STARTED", map[string]interface{}{
+		"connector_state": state,
+	})
+	return ipsecStateStarted
+}
+
+// setIPsecState starts or stops the IPsec tunnel of the network connector
+// with the given ID, depending on state.
+func setIPsecState(c *cloudconnexa.Client, id, state string) error {
+	if state == ipsecStateStopped {
+		return c.NetworkConnectors.StopIPsec(id)
+	}
+	return c.NetworkConnectors.StartIPsec(id)
+}
+
+// setRemotePeerConfig renders the `remote_peer_config` of connector into d.
+// A pre-shared key managed through its write-only variant is rendered as a
+// placeholder so that it does not end up in state.
+func setRemotePeerConfig(d *schema.ResourceData, connector *cloudconnexa.NetworkConnector) error {
+	var preSharedKey string
+	if _, ok := d.GetOk("ipsec_config.0.pre_shared_key_wo_version"); !ok && connector.IPSecConfig != nil {
+		preSharedKey = connector.IPSecConfig.PreSharedKey
+	}
+	config, err := renderRemotePeerConfig(connector, preSharedKey)
+	if err != nil {
+		return err
+	}
+	return d.Set("remote_peer_config", config)
+}
+
 // resourceNetworkConnectorDelete deletes a network connector
 func resourceNetworkConnectorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	err := c.NetworkConnectors.Delete(d.Id(), d.Get("network_id").(string))
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
@@ -371,6 +529,7 @@ func resourceDataToNetworkConnector(data *schema.ResourceData) cloudconnexa.Netw
 	ipSecConfigs := data.Get("ipsec_config").([]interface{})
 	if len(ipSecConfigs) > 0 {
 		ipSecConfigData := ipSecConfigs[0].(map[string]interface{})
+		applyIPsecPreset(data.GetRawConfig(), ipSecConfigData)
 		ipSecConfig := &cloudconnexa.IPSecConfig{
 			Platform:                     ipSecConfigData["platform"].(string),
 			AuthenticationType:           ipSecConfigData["authentication_type"].(string),
@@ -409,6 +568,15 @@ func resourceDataToNetworkConnector(data *schema.ResourceData) cloudconnexa.Netw
 			Hostname: ipSecConfigData["hostname"].(string),
 			Domain:   ipSecConfigData["domain"].(string),
 		}
+		if v := ipSecWriteOnlySecret(data, "pre_shared_key"); v != "" {
+			ipSecConfig.PreSharedKey = v
+		}
+		if v := ipSecWriteOnlySecret(data, "peer_certificate_private_key"); v != "" {
+			ipSecConfig.PeerCertificatePrivateKey = v
+		}
+		if v := ipSecWriteOnlySecret(data, "peer_certificate_key_passphrase"); v != "" {
+			ipSecConfig.PeerCertificateKeyPassphrase = v
+		}
 		connector.IPSecConfig = ipSecConfig
 	}
 	return connector
@@ -437,6 +605,15 @@ func setNetworkConnectorData(d *schema.ResourceData, connector *cloudconnexa.Net
 		ipSecConfig["remote_gateway_certificate"] = connector.IPSecConfig.RemoteGatewayCertificate
 		ipSecConfig["peer_certificate_private_key"] = connector.IPSecConfig.PeerCertificatePrivateKey
 		ipSecConfig["peer_certificate_key_passphrase"] = connector.IPSecConfig.PeerCertificateKeyPassphrase
+		// Secrets managed through their write-only variant must not be copied
+		// from the API into state; only the version trigger is kept. Data
+		// sources have no such attributes, so GetOk never matches for them.
+		for _, secret := range ipSecWriteOnlySecrets {
+			if version, ok := d.GetOk("ipsec_config.0." + secret + "_wo_version"); ok {
+				ipSecConfig[secret+"_wo_version"] = version
+				ipSecConfig[secret] = ""
+			}
+		}
 		ipSecConfig["protocol_version"] = connector.IPSecConfig.IkeProtocol.ProtocolVersion
 		ipSecConfig["phase_1_encryption_algorithms"] = connector.IPSecConfig.IkeProtocol.Phase1.EncryptionAlgorithms
 		ipSecConfig["phase_1_integrity_algorithms"] = connector.IPSecConfig.IkeProtocol.Phase1.IntegrityAlgorithms
@@ -465,3 +642,14 @@ func toStrings(strings []interface{}) []string {
 	}
 	return array
 }
+
+// ipSecWriteOnlySecret returns the configured value of the write-only IPsec
+// attribute `<name>_wo`, or "" if it is not set. Write-only values are only
+// available from the raw configuration, never through d.Get.
+func ipSecWriteOnlySecret(d *schema.ResourceData, name string) string {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath("ipsec_config").IndexInt(0).GetAttr(name + "_wo"))
+	if diags.HasError() || !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+	return v.AsString()
+}
@@ -0,0 +1,153 @@
+package cloudconnexa
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// objectWithNulls returns an object of type ty with the given attributes set
+// and all others null, for building raw configurations in tests.
+func objectWithNulls(ty cty.Type, attrs map[string]cty.Value) cty.Value {
+	vals := map[string]cty.Value{}
+	for name, at := range ty.AttributeTypes() {
+		vals[name] = cty.NullVal(at)
+	}
+	for name, v := range attrs {
+		vals[name] = v
+	}
+	return cty.ObjectVal(vals)
+}
+
+// TestUnitIPSecWriteOnlySecret checks that write-only IPsec secrets are read
+// from the raw configuration.
+func TestUnitIPSecWriteOnlySecret(t *testing.T) {
+	r := resourceNetworkConnector()
+	ty := r.CoreConfigSchema().ImpliedType()
+	ipsecTy := ty.AttributeType("ipsec_config").ElementType()
+	raw := objectWithNulls(ty, map[string]cty.Value{
+		"ipsec_config": cty.ListVal([]cty.Value{objectWithNulls(ipsecTy, map[string]cty.Value{
+			"pre_shared_key_wo":         cty.StringVal("rotated-secret"),
+			"pre_shared_key_wo_version": cty.NumberIntVal(2),
+		})}),
+	})
+
+	d := r.Data(&terraform.InstanceState{ID: "connector-id", RawConfig: raw})
+	assert.Equal(t, "rotated-secret", ipSecWriteOnlySecret(d, "pre_shared_key"))
+	assert.Empty(t, ipSecWriteOnlySecret(d, "peer_certificate_private_key"))
+
+	d = r.Data(&terraform.InstanceState{ID: "connector-id", RawConfig: objectWithNulls(ty, nil)})
+	assert.Empty(t, ipSecWriteOnlySecret(d, "pre_shared_key"))
+}
+
+// TestUnitIPsecStateFromAPI checks that only STOPPED reads as a stopped
+// tunnel.
+func TestUnitIPsecStateFromAPI(t *testing.T) {
+	for state, want := range map[string]string{
+		"STARTED": ipsecStateStarted,
+		"STOPPED": ipsecStateStopped,
+		"ACTIVE":  ipsecStateStarted,
+	} {
+		assert.Equal(t, want, ipsecStateFromAPI(context.Background(), state), state)
+	}
+}
+
+// TestUnitSetNetworkConnectorData_WriteOnlySecrets checks that secrets set
+// through their write-only variant are not copied from the API into state,
+// while plain secrets and the data source keep working as before.
+func TestUnitSetNetworkConnectorData_WriteOnlySecrets(t *testing.T) {
+	connector := &cloudconnexa.NetworkConnector{
+		ID: "connector-id",
+		IPSecConfig: &cloudconnexa.IPSecConfig{
+			Platform:                  "AWS",
+			PreSharedKey:              "api-secret",
+			PeerCertificatePrivateKey: "api-key",
+		},
+	}
+	r := resourceNetworkConnector()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"ipsec_config": []interface{}{map[string]interface{}{
+			"platform":                  "AWS",
+			"pre_shared_key_wo_version": 3,
+		}},
+	})
+	setNetworkConnectorData(d, connector)
+	assert.Empty(t, d.Get("ipsec_config.0.pre_shared_key"))
+	assert.Equal(t, 3, d.Get("ipsec_config.0.pre_shared_key_wo_version"))
+	assert.Equal(t, "api-key", d.Get("ipsec_config.0.peer_certificate_private_key"))
+
+	ds := dataSourceNetworkConnector()
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{})
+	setNetworkConnectorData(d, connector)
+	require.Equal(t, 1, d.Get("ipsec_config.#"))
+	assert.Equal(t, "api-secret", d.Get("ipsec_config.0.pre_shared_key"))
+}
+
+// TestUnitFakeAPIIPsecState checks that the tunnel state is read back and
+// that the tunnel is only started or stopped when `ipsec_state` changes.
+func TestUnitFakeAPIIPsecState(t *testing.T) {
+	_, p := newFakeAPIProvider(t, fakeapi.Options{})
+	ctx := context.Background()
+	c := clientFromMeta(ctx, p.Meta())
+	network, err := c.Networks.Create(cloudconnexa.Network{Name: "vpc", InternetAccess: "SPLIT_TUNNEL_ON", Routes: []cloudconnexa.Route{{Subnet: "10.2.0.0/16", Type: "IP_V4"}}})
+	require.NoError(t, err)
+
+	r := p.ResourcesMap["cloudconnexa_network_connector"]
+	config := func(description, ipsecState string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":          "VPC GW",
+			"description":   description,
+			"vpn_region_id": "us-east-1",
+			"network_id":    network.ID,
+			"ipsec_state":   ipsecState,
+			"ipsec_config": []interface{}{map[string]interface{}{
+				"platform":              "AWS",
+				"authentication_type":   "SHARED_SECRET",
+				"remote_site_public_ip": "203.0.113.30",
+				"pre_shared_key":        "secret-psk",
+			}},
+		}
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, config("Managed by Terraform", ipsecStateStarted))
+	tunnelState := func() string {
+		connector, err := c.NetworkConnectors.GetByID(d.Id())
+		require.NoError(t, err)
+		return connector.IPSecConfig.ConnectorState
+	}
+	apply := func(state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+		diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), p.Meta())
+		require.NoError(t, err)
+		state, diags := r.Apply(ctx, state, diff, p.Meta())
+		require.False(t, diags.HasError(), "%v", diags)
+		return state
+	}
+
+	diags := r.CreateContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, fakeapi.IPsecStarted, tunnelState())
+
+	require.NoError(t, c.NetworkConnectors.StopIPsec(d.Id()))
+	diags = r.ReadContext(ctx, d, p.Meta())
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, ipsecStateStopped, d.Get("ipsec_state"), "drift is read back")
+
+	state := apply(d.State(), config("edited", ipsecStateStopped))
+	assert.Equal(t, "edited", state.Attributes["description"])
+	assert.Equal(t, fakeapi.IPsecStopped, tunnelState(), "editing the description does not start the tunnel")
+
+	state = apply(state, config("edited", ipsecStateStarted))
+	assert.Equal(t, ipsecStateStarted, state.Attributes["ipsec_state"])
+	assert.Equal(t, fakeapi.IPsecStarted, tunnelState())
+
+	state = apply(state, config("edited", ipsecStateStopped))
+	assert.Equal(t, ipsecStateStopped, state.Attributes["ipsec_state"])
+	assert.Equal(t, fakeapi.IPsecStopped, tunnelState())
+}
@@ -26,6 +26,7 @@ func resourceNetworkIPService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -69,7 +70,7 @@ func resourceNetworkIPService() *schema.Resource {
 
 // resourceNetworkIpServiceUpdate updates an existing network IP service
 func resourceNetworkIpServiceUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 
 	_, err := c.NetworkIPServices.Update(data.Id(), resourceDataToNetworkIpService(data))
 	if err != nil {
@@ -130,10 +131,14 @@ func customNetworkIpServiceTypesConfig() map[string]*schema.Schema {
 
 // resourceNetworkIpServiceRead retrieves a network IP service by ID
 func resourceNetworkIpServiceRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	id := data.Id()
 	service, err := c.NetworkIPServices.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, data, "network IP service")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get network IP service with ID: %s, %s", id, err)...)
 	}
@@ -158,10 +163,10 @@ func setNetworkIpServiceResourceData(data *schema.ResourceData, service *cloudco
 
 // resourceNetworkIpServiceDelete deletes a network IP service
 func resourceNetworkIpServiceDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	err := c.NetworkIPServices.Delete(data.Id())
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
@@ -212,7 +217,7 @@ func flattenNetworkIpServiceRoutes(routes []*cloudconnexa.Route) []string {
 
 // resourceNetworkIpServiceCreate creates a new network IP service
 func resourceNetworkIpServiceCreate(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*cloudconnexa.Client)
+	client := clientFromMeta(ctx, m)
 
 	service := resourceDataToNetworkIpService(data)
 	createdService, err := client.NetworkIPServices.Create(service)
@@ -2,171 +2,214 @@ package cloudconnexa
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// resourceRoute returns a Terraform resource for CloudConnexa routes.
-// This resource allows users to create, read, update, and delete routes on a CloudConnexa network.
+// routeResource is the `cloudconnexa_route` resource, which creates,
+// reads, updates and deletes routes on a CloudConnexa network. It is served
+// by the framework provider.
+type routeResource struct {
+	frameworkResource
+}
+
+var (
+	_ resource.ResourceWithConfigure   = (*routeResource)(nil)
+	_ resource.ResourceWithIdentity    = (*routeResource)(nil)
+	_ resource.ResourceWithImportState = (*routeResource)(nil)
+)
+
+// routeResourceModel is the state of a `cloudconnexa_route`.
+type routeResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Type          types.String   `tfsdk:"type"`
+	Subnet        types.String   `tfsdk:"subnet"`
+	NetworkItemID types.String   `tfsdk:"network_item_id"`
+	Description   types.String   `tfsdk:"description"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// newRouteResource returns a Terraform resource for CloudConnexa routes.
 //
 // Returns:
-//   - *schema.Resource: A Terraform resource definition for routes
-func resourceRoute() *schema.Resource {
-	return &schema.Resource{
-		Description:   "Use `cloudconnexa_route` to create a route on an CloudConnexa network.",
-		CreateContext: resourceRouteCreate,
-		UpdateContext: resourceRouteUpdate,
-		ReadContext:   resourceRouteRead,
-		DeleteContext: resourceRouteDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"IP_V4", "IP_V6"}, false),
-				Description:  "The type of route. Valid values are `IP_V4` and `IP_V6`.",
+//   - resource.Resource: A Terraform resource definition for routes
+func newRouteResource() resource.Resource {
+	return &routeResource{frameworkResource{typeName: "cloudconnexa_route"}}
+}
+
+// Schema implements resource.Resource. The schema matches that of the
+// former SDKv2 resource, so existing state keeps working.
+func (r *routeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use `cloudconnexa_route` to create a route on an CloudConnexa network.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of this resource.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of route. Valid values are `IP_V4` and `IP_V6`.",
+				Validators:          []validator.String{stringvalidator.OneOf("IP_V4", "IP_V6")},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"subnet": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The target value of the default route.",
+			"subnet": schema.StringAttribute{
+				Required:      true,
+				Description:   "The target value of the default route.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"network_item_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The id of the network on which to create the route.",
+			"network_item_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The id of the network on which to create the route.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"description": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "Managed by Terraform",
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Managed by Terraform"),
+				MarkdownDescription: "The description for the UI. Defaults to `Managed by Terraform`.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
-// resourceRouteCreate handles the creation of a new CloudConnexa route.
-// It creates a route with the specified type, subnet, and description on the given network.
-//
-// Parameters:
-//   - ctx: The context for the operation
-//   - d: The Terraform resource data
-//   - m: The interface containing the CloudConnexa client
-//
-// Returns:
-//   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
-func resourceRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
-	var diags diag.Diagnostics
-	networkItemId := d.Get("network_item_id").(string)
-	routeType := d.Get("type").(string)
-	routeSubnet := d.Get("subnet").(string)
-	descriptionValue := d.Get("description").(string)
-	r := cloudconnexa.Route{
-		Type:        routeType,
-		Subnet:      routeSubnet,
-		Description: descriptionValue,
+// Create implements resource.Resource. It creates a route with the
+// specified type, subnet, and description on the given network.
+func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan routeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	timeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	route, err := c.Routes.Create(networkItemId, r)
-	if err != nil {
-		return append(diags, diag.FromErr(err)...)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := r.writableClientFor(ctx, "create", &resp.Diagnostics)
+	if c == nil {
+		return
 	}
-	d.SetId(route.ID)
-	if routeType == "IP_V4" || routeType == "IP_V6" {
-		d.Set("subnet", route.Subnet)
+
+	route, err := c.Routes.Create(plan.NetworkItemID.ValueString(), cloudconnexa.Route{
+		Type:        plan.Type.ValueString(),
+		Subnet:      plan.Subnet.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create route", err.Error())
+		return
 	}
-	return diags
+	plan.ID = types.StringValue(route.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setIdentity(ctx, resp.Identity, route.ID, &resp.Diagnostics)
 }
 
-// resourceRouteRead handles the read operation for a CloudConnexa route.
-// It retrieves the route information and updates the Terraform state.
-//
-// Parameters:
-//   - ctx: The context for the operation
-//   - d: The Terraform resource data
-//   - m: The interface containing the CloudConnexa client
-//
-// Returns:
-//   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
-func resourceRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
-	var diags diag.Diagnostics
-	id := d.Id()
-	r, err := c.Routes.Get(id)
+// Read implements resource.Resource. It retrieves the route and updates
+// the state, or removes the route from it if it no longer exists.
+func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state routeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	timeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := r.clientFor(ctx, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	id := state.ID.ValueString()
+	route, err := c.Routes.Get(id)
+	if isNotFoundErr(err) || (err == nil && route == nil) {
+		removeResourceFromState(ctx, &resp.State, "route", id)
+		return
+	}
 	if err != nil {
-		return append(diags, diag.Errorf("Failed to get route with ID: %s, %s", id, err)...)
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get route with ID: %s", id), err.Error())
+		return
 	}
-	if r == nil {
-		d.SetId("")
-	} else {
-		d.Set("type", r.Type)
-		if r.Type == "IP_V4" || r.Type == "IP_V6" {
-			d.Set("subnet", r.Subnet)
-		}
-		d.Set("description", r.Description)
-		d.Set("network_item_id", r.NetworkItemID)
+	state.Type = types.StringValue(route.Type)
+	if route.Type == "IP_V4" || route.Type == "IP_V6" {
+		state.Subnet = types.StringValue(route.Subnet)
 	}
-	return diags
+	state.Description = types.StringValue(route.Description)
+	state.NetworkItemID = types.StringValue(route.NetworkItemID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	setIdentity(ctx, resp.Identity, id, &resp.Diagnostics)
 }
 
-// resourceRouteUpdate handles the update operation for a CloudConnexa route.
-// It updates the route's description and subnet if they have changed.
-//
-// Parameters:
-//   - ctx: The context for the operation
-//   - d: The Terraform resource data
-//   - m: The interface containing the CloudConnexa client
-//
-// Returns:
-//   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
-func resourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
-	var diags diag.Diagnostics
-	if !d.HasChanges("description", "subnet") {
-		return diags
+// Update implements resource.Resource. Only the description can change in
+// place; changing any other argument replaces the route.
+func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state routeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	timeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	_, description := d.GetChange("description")
-	_, subnet := d.GetChange("subnet")
-	r := cloudconnexa.Route{
-		ID:          d.Id(),
-		Description: description.(string),
-		Subnet:      subnet.(string),
+	if !plan.Description.Equal(state.Description) {
+		c := r.writableClientFor(ctx, "update", &resp.Diagnostics)
+		if c == nil {
+			return
+		}
+		// The endpoint replaces the route value as well, so the unchanged
+		// subnet from state is sent along with the new description.
+		err := c.Routes.Update(cloudconnexa.Route{
+			ID:          state.ID.ValueString(),
+			Description: plan.Description.ValueString(),
+			Subnet:      state.Subnet.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update route", err.Error())
+			return
+		}
 	}
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
 
-	err := c.Routes.Update(r)
-	if err != nil {
-		return append(diags, diag.FromErr(err)...)
+// Delete implements resource.Resource. A route that no longer exists is
+// considered deleted.
+func (r *routeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state routeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	timeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	c := r.writableClientFor(ctx, "delete", &resp.Diagnostics)
+	if c == nil {
+		return
 	}
-	return diags
-}
 
-// resourceRouteDelete handles the deletion of a CloudConnexa route.
-// It removes the route from the CloudConnexa network.
-//
-// Parameters:
-//   - ctx: The context for the operation
-//   - d: The Terraform resource data
-//   - m: The interface containing the CloudConnexa client
-//
-// Returns:
-//   - diag.Diagnostics: Diagnostics containing any errors that occurred during the operation
-func resourceRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
-	var diags diag.Diagnostics
-	routeId := d.Id()
-	err := c.Routes.Delete(routeId)
-	if err != nil {
-		return append(diags, diag.FromErr(err)...)
+	err := c.Routes.Delete(state.ID.ValueString())
+	if err != nil && !isNotFoundErr(err) {
+		resp.Diagnostics.AddError("Failed to delete route", err.Error())
 	}
-	return diags
 }
@@ -39,9 +39,9 @@ func TestAccCloudConnexaRoute_basic(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaRouteDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaRouteDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaRouteConfig(route, networkRandString),
@@ -21,6 +21,7 @@ func resourceSettings() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSettingsImport,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"allow_trusted_devices": {
 				Type:     schema.TypeBool,
@@ -227,7 +228,7 @@ func subnetSchema() *schema.Resource {
 
 // resourceSettingsUpdate handles the creation and update of CloudConnexa settings
 func resourceSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	if d.HasChange("allow_trusted_devices") {
@@ -423,7 +424,7 @@ func resourceSettingsUpdate(ctx context.Context, d *schema.ResourceData, m inter
 // and updates the Terraform state with the current values.
 // All API calls are unconditional to ensure proper import functionality.
 func resourceSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	allowTrustedDevices, err := c.Settings.GetTrustedDevicesAllowed()
@@ -13,8 +13,8 @@ func TestAccCloudConnexaSettings_import(t *testing.T) {
 	rn := "cloudconnexa_settings.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaSettingsConfig(),
@@ -35,8 +35,8 @@ func TestAccCloudConnexaSettings_basic(t *testing.T) {
 	rn := "cloudconnexa_settings.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaSettingsConfig(),
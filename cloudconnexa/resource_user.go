@@ -20,6 +20,7 @@ func resourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:         schema.TypeString,
@@ -121,7 +122,7 @@ func resourceUser() *schema.Resource {
 
 // resourceUserCreate creates a new CloudConnexa user
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	username := d.Get("username").(string)
 	email := d.Get("email").(string)
@@ -165,11 +166,15 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 // resourceUserRead retrieves information about an existing CloudConnexa user
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	id := d.Id()
 	u, err := c.Users.Get(id)
 
+	if isNotFoundErr(err) {
+		removeFromState(ctx, d, "user")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get user with ID: %s, %s", id, err)...)
 	}
@@ -205,7 +210,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 // resourceUserUpdate updates an existing CloudConnexa user's information
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 
 	// Handle status change (suspend/activate)
@@ -259,11 +264,11 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 // resourceUserDelete removes an existing CloudConnexa user
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	userId := d.Id()
 	err := c.Users.Delete(userId)
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	return diags
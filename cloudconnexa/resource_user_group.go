@@ -24,6 +24,7 @@ func resourceUserGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -123,7 +124,7 @@ func tunnelBypassSchema() *schema.Resource {
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred
 func resourceUserGroupUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	ug := resourceDataToUserGroup(data)
 
@@ -241,10 +242,10 @@ func updateUserGroupData(data *schema.ResourceData, userGroup *cloudconnexa.User
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred
 func resourceUserGroupDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	err := c.UserGroups.Delete(data.Id())
-	if err != nil {
+	if err != nil && !isNotFoundErr(err) {
 		return append(diags, diag.FromErr(err)...)
 	}
 	data.SetId("")
@@ -262,10 +263,14 @@ func resourceUserGroupDelete(ctx context.Context, data *schema.ResourceData, i i
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred
 func resourceUserGroupRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	c := i.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, i)
 	var diags diag.Diagnostics
 	id := data.Id()
 	userGroup, err := c.UserGroups.Get(id)
+	if isNotFoundErr(err) {
+		removeFromState(ctx, data, "user group")
+		return diags
+	}
 	if err != nil {
 		return append(diags, diag.Errorf("Failed to get user group with ID: %s, %s", id, err)...)
 	}
@@ -289,7 +294,7 @@ func resourceUserGroupRead(ctx context.Context, data *schema.ResourceData, i int
 // Returns:
 //   - diag.Diagnostics: Diagnostics containing any errors that occurred
 func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*cloudconnexa.Client)
+	c := clientFromMeta(ctx, m)
 	var diags diag.Diagnostics
 	ug := resourceDataToUserGroup(d)
 
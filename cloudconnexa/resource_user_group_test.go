@@ -38,9 +38,9 @@ func TestAccCloudConnexaUserGroup_basic(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckCloudConnexaUserGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudConnexaUserGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudConnexaUserGroupConfig(userGroup),
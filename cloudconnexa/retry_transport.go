@@ -0,0 +1,208 @@
+package cloudconnexa
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Default values for the provider `retry` block.
+const (
+	defaultRetryMaxAttempts = 5
+	defaultRetryMinBackoff  = time.Second
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// retryConfig controls how retryTransport retries failed API requests.
+type retryConfig struct {
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+// defaultRetryConfig returns the retry settings used when the provider
+// configuration has no `retry` block.
+func defaultRetryConfig() retryConfig {
+	return retryConfig{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+	}
+}
+
+// expandRetryConfig converts the provider `retry` block into a retryConfig,
+// falling back to the defaults when the block is absent.
+//
+// Parameters:
+//   - l: The raw `retry` list from the provider configuration
+//
+// Returns:
+//   - retryConfig: The resulting retry settings
+//   - error: An error if a duration cannot be parsed or the bounds are inverted
+func expandRetryConfig(l []interface{}) (retryConfig, error) {
+	cfg := defaultRetryConfig()
+	if len(l) == 0 || l[0] == nil {
+		return cfg, nil
+	}
+	raw := l[0].(map[string]interface{})
+	if v, ok := raw["max_attempts"].(int); ok && v > 0 {
+		cfg.MaxAttempts = v
+	}
+	var err error
+	if v, ok := raw["min_backoff"].(string); ok && v != "" {
+		if cfg.MinBackoff, err = time.ParseDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid retry.min_backoff: %w", err)
+		}
+	}
+	if v, ok := raw["max_backoff"].(string); ok && v != "" {
+		if cfg.MaxBackoff, err = time.ParseDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid retry.max_backoff: %w", err)
+		}
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		return cfg, fmt.Errorf("retry.max_backoff (%s) must not be lower than retry.min_backoff (%s)", cfg.MaxBackoff, cfg.MinBackoff)
+	}
+	return cfg, nil
+}
+
+// retryTransport is an http.RoundTripper that retries rate-limited (429),
+// server-side (5xx) and connection-reset failures with jittered exponential
+// backoff, honoring the Retry-After header when the API sends one. Requests
+// that are not idempotent, such as the POST creating an object, are only
+// retried when the API has certainly not processed them: on 429, or on 503
+// with a Retry-After header. A 502 or a reset connection may arrive after
+// the object was created, and replaying the request would create it twice.
+type retryTransport struct {
+	next   http.RoundTripper
+	config retryConfig
+
+	// sleep waits for d or until the request context is done. It is a field
+	// so unit tests can skip real waiting.
+	sleep func(req *http.Request, d time.Duration) error
+}
+
+// newRetryTransport wraps next with retry behaviour described by config.
+func newRetryTransport(next http.RoundTripper, config retryConfig) *retryTransport {
+	return &retryTransport{next: next, config: config, sleep: sleepContext}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(req)
+		if attempt >= t.config.MaxAttempts || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			// Drain so the connection can be reused by the next attempt.
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			_ = resp.Body.Close()
+		}
+		if err := t.sleep(req, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry reports whether the outcome of a single attempt is transient
+// and safe to replay. Requests whose body cannot be replayed are never
+// retried.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if !isIdempotent(req.Method) {
+		return err == nil && (resp.StatusCode == http.StatusTooManyRequests ||
+			(resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") != ""))
+	}
+	if err != nil {
+		return isConnectionReset(err)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests ||
+		(resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented)
+}
+
+// isIdempotent reports whether requests with method can be sent more than
+// once with the same effect as sending them once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header takes precedence (capped at MaxBackoff); otherwise the delay grows
+// exponentially from MinBackoff with full jitter.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(d, t.config.MaxBackoff)
+		}
+	}
+	ceiling := t.config.MinBackoff << (attempt - 1)
+	if ceiling <= 0 || ceiling > t.config.MaxBackoff {
+		ceiling = t.config.MaxBackoff
+	}
+	if ceiling <= t.config.MinBackoff {
+		return ceiling
+	}
+	return t.config.MinBackoff + rand.N(ceiling-t.config.MinBackoff)
+}
+
+// parseRetryAfter interprets a Retry-After header given either as a number of
+// seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// isConnectionReset reports whether err is a connection dropped by the
+// server, which is transient for idempotent requests. A bare io.EOF is not
+// included: net/http already retries idempotent requests on a reused
+// connection that was closed before any response.
+func isConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// sleepContext waits for d, returning early with the context error if the
+// request is cancelled first.
+func sleepContext(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
@@ -0,0 +1,303 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRetryTransport returns a retryTransport over http.DefaultTransport
+// that records the requested waits instead of sleeping.
+func newTestRetryTransport(cfg retryConfig, waits *[]time.Duration) *retryTransport {
+	t := newRetryTransport(http.DefaultTransport, cfg)
+	t.sleep = func(_ *http.Request, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+	return t
+}
+
+// flakyHandler fails the first `failures` requests with status and succeeds
+// afterwards, counting every call.
+func flakyHandler(failures int32, status int, header http.Header, calls *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := calls.Add(1)
+		if n <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	})
+}
+
+// TestUnitRetryTransport_RetriesTransientStatuses checks that 429 and 5xx
+// responses are retried until the API succeeds.
+func TestUnitRetryTransport_RetriesTransientStatuses(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(flakyHandler(2, status, nil, &calls))
+			t.Cleanup(server.Close)
+
+			var waits []time.Duration
+			client := &http.Client{Transport: newTestRetryTransport(defaultRetryConfig(), &waits)}
+			resp, err := client.Get(server.URL)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.EqualValues(t, 3, calls.Load())
+			assert.Len(t, waits, 2)
+		})
+	}
+}
+
+// TestUnitRetryTransport_HonorsRetryAfter checks that a Retry-After header
+// overrides the computed backoff and is capped at MaxBackoff.
+func TestUnitRetryTransport_HonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	header := http.Header{"Retry-After": []string{"7"}}
+	server := httptest.NewServer(flakyHandler(1, http.StatusTooManyRequests, header, &calls))
+	t.Cleanup(server.Close)
+
+	var waits []time.Duration
+	cfg := retryConfig{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Second}
+	client := &http.Client{Transport: newTestRetryTransport(cfg, &waits)}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, []time.Duration{5 * time.Second}, waits)
+}
+
+// TestUnitRetryTransport_GivesUpAfterMaxAttempts checks that the last
+// response is returned once the attempt budget is exhausted.
+func TestUnitRetryTransport_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(flakyHandler(10, http.StatusInternalServerError, nil, &calls))
+	t.Cleanup(server.Close)
+
+	var waits []time.Duration
+	cfg := retryConfig{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Second}
+	client := &http.Client{Transport: newTestRetryTransport(cfg, &waits)}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.EqualValues(t, 3, calls.Load())
+}
+
+// TestUnitRetryTransport_DoesNotRetryClientErrors checks that non-transient
+// 4xx responses are returned immediately.
+func TestUnitRetryTransport_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(flakyHandler(10, http.StatusBadRequest, nil, &calls))
+	t.Cleanup(server.Close)
+
+	var waits []time.Duration
+	client := &http.Client{Transport: newTestRetryTransport(defaultRetryConfig(), &waits)}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.EqualValues(t, 1, calls.Load())
+	assert.Empty(t, waits)
+}
+
+// TestUnitRetryTransport_ReplaysRequestBody checks that request bodies are
+// sent again in full on every attempt.
+func TestUnitRetryTransport_ReplaysRequestBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var waits []time.Duration
+	client := &http.Client{Transport: newTestRetryTransport(defaultRetryConfig(), &waits)}
+	req, err := http.NewRequest(http.MethodPut, server.URL, bytes.NewBufferString(`{"name":"x"}`))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, []string{`{"name":"x"}`, `{"name":"x"}`}, bodies)
+}
+
+// TestUnitRetryTransport_PostRetries checks that a POST, which may already
+// have created an object when it fails, is only replayed when the API
+// rejected it before processing: on 429, or on 503 with Retry-After.
+func TestUnitRetryTransport_PostRetries(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		status int
+		header http.Header
+		calls  int32
+	}{
+		{"bad gateway", http.StatusBadGateway, nil, 1},
+		{"internal server error", http.StatusInternalServerError, nil, 1},
+		{"unavailable", http.StatusServiceUnavailable, nil, 1},
+		{"unavailable with retry-after", http.StatusServiceUnavailable, http.Header{"Retry-After": []string{"1"}}, 2},
+		{"rate limited", http.StatusTooManyRequests, nil, 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(flakyHandler(1, tc.status, tc.header, &calls))
+			t.Cleanup(server.Close)
+
+			var waits []time.Duration
+			client := &http.Client{Transport: newTestRetryTransport(defaultRetryConfig(), &waits)}
+			resp, err := client.Post(server.URL, "application/json", bytes.NewBufferString(`{"name":"x"}`))
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, tc.calls, calls.Load())
+		})
+	}
+}
+
+// TestUnitRetryTransport_PostNotReplayedAfterReset checks that a POST whose
+// connection drops is not sent again.
+func TestUnitRetryTransport_PostNotReplayedAfterReset(t *testing.T) {
+	var calls atomic.Int32
+	next := roundTripFunc(func(*http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return nil, syscall.ECONNRESET
+	})
+	var waits []time.Duration
+	transport := newTestRetryTransport(defaultRetryConfig(), &waits)
+	transport.next = next
+
+	req, err := http.NewRequest(http.MethodPost, "http://api.invalid/hosts", bytes.NewBufferString(`{}`))
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.ErrorIs(t, err, syscall.ECONNRESET)
+	assert.EqualValues(t, 1, calls.Load())
+
+	req, err = http.NewRequest(http.MethodGet, "http://api.invalid/hosts", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.ErrorIs(t, err, syscall.ECONNRESET)
+	assert.EqualValues(t, 1+defaultRetryMaxAttempts, calls.Load())
+}
+
+// TestUnitRetryTransport_StopsOnContextCancel checks that a cancelled request
+// context interrupts the backoff wait.
+func TestUnitRetryTransport_StopsOnContextCancel(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(flakyHandler(10, http.StatusServiceUnavailable, nil, &calls))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	transport := newRetryTransport(http.DefaultTransport, retryConfig{MaxAttempts: 5, MinBackoff: time.Hour, MaxBackoff: time.Hour})
+	transport.sleep = func(req *http.Request, d time.Duration) error {
+		cancel()
+		return sleepContext(req, d)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Do(req)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+// TestUnitRetryTransport_ClientIntegration drives a real go-client call
+// through the retry transport installed with Client.WithHTTPClient.
+func TestUnitRetryTransport_ClientIntegration(t *testing.T) {
+	var calls atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"host-id","name":"my-host"}`))
+	})
+	c := newHostUnitTestClient(t, handler)
+
+	var waits []time.Duration
+	c = c.WithHTTPClient(&http.Client{Transport: newTestRetryTransport(defaultRetryConfig(), &waits)})
+
+	h, err := c.Hosts.Get("host-id")
+	require.NoError(t, err)
+	assert.Equal(t, "my-host", h.Name)
+	assert.EqualValues(t, 3, calls.Load())
+}
+
+// TestUnitBackoffBounds checks that computed waits stay within the
+// configured bounds.
+func TestUnitBackoffBounds(t *testing.T) {
+	rt := newRetryTransport(nil, retryConfig{MaxAttempts: 10, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second})
+	for attempt := 1; attempt <= 10; attempt++ {
+		d := rt.backoff(attempt, nil)
+		assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+		assert.LessOrEqual(t, d, time.Second)
+	}
+}
+
+// TestUnitParseRetryAfter covers the delta-seconds and HTTP-date forms.
+func TestUnitParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	d, ok := parseRetryAfter("3", now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	d, ok = parseRetryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, d)
+
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+// TestUnitIsConnectionReset checks which transport errors are retried.
+func TestUnitIsConnectionReset(t *testing.T) {
+	assert.True(t, isConnectionReset(syscall.ECONNRESET))
+	assert.True(t, isConnectionReset(io.ErrUnexpectedEOF))
+	assert.False(t, isConnectionReset(io.EOF))
+	assert.False(t, isConnectionReset(context.DeadlineExceeded))
+}
+
+// TestUnitExpandRetryConfig covers defaults, overrides and invalid bounds.
+func TestUnitExpandRetryConfig(t *testing.T) {
+	cfg, err := expandRetryConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaultRetryConfig(), cfg)
+
+	cfg, err = expandRetryConfig([]interface{}{map[string]interface{}{
+		"max_attempts": 2,
+		"min_backoff":  "250ms",
+		"max_backoff":  "2s",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, retryConfig{MaxAttempts: 2, MinBackoff: 250 * time.Millisecond, MaxBackoff: 2 * time.Second}, cfg)
+
+	_, err = expandRetryConfig([]interface{}{map[string]interface{}{
+		"min_backoff": "10s",
+		"max_backoff": "1s",
+	}})
+	assert.Error(t, err)
+}
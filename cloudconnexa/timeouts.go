@@ -0,0 +1,24 @@
+package cloudconnexa
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultOperationTimeout is the default deadline for each CRUD operation of
+// a resource. It is generous because API calls share the provider-wide rate
+// limiters and may wait behind other resources during large applies.
+const defaultOperationTimeout = 20 * time.Minute
+
+// defaultResourceTimeouts returns the `timeouts` block settings shared by
+// every resource. The deadline is applied to the operation context by the
+// SDK and reaches the HTTP layer through clientFromMeta.
+func defaultResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultOperationTimeout),
+		Read:   schema.DefaultTimeout(defaultOperationTimeout),
+		Update: schema.DefaultTimeout(defaultOperationTimeout),
+		Delete: schema.DefaultTimeout(defaultOperationTimeout),
+	}
+}
@@ -0,0 +1,99 @@
+package cloudconnexa
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// CACertFileEnvVar is the environment variable name for the `ca_cert_file`
+// provider argument.
+const CACertFileEnvVar = "CLOUDCONNEXA_CA_CERT_FILE"
+
+// tlsVersions maps the accepted `min_tls_version` values to their crypto/tls
+// constants.
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// defaultMinTLSVersion is used when `min_tls_version` is not set.
+const defaultMinTLSVersion = "1.2"
+
+// transportConfig holds the network settings of the provider block that
+// apply to the innermost HTTP transport.
+type transportConfig struct {
+	ProxyURL           string
+	CACertFile         string
+	CACertPEM          string
+	InsecureSkipVerify bool
+	MinTLSVersion      string
+}
+
+// apply configures t according to cfg. An empty ProxyURL keeps the proxy
+// taken from the HTTPS_PROXY/NO_PROXY environment variables, and custom CA
+// certificates are trusted in addition to the system roots.
+//
+// Parameters:
+//   - t: The transport to configure
+//
+// Returns:
+//   - error: An error if the proxy URL, the CA bundle or the TLS version is invalid
+func (cfg transportConfig) apply(t *http.Transport) error {
+	if cfg.ProxyURL != "" {
+		u, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy_url: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return fmt.Errorf("invalid proxy_url %q: scheme must be http, https or socks5", cfg.ProxyURL)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("invalid proxy_url %q: missing host", cfg.ProxyURL)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+
+	tlsConfig := t.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	minVersion := cfg.MinTLSVersion
+	if minVersion == "" {
+		minVersion = defaultMinTLSVersion
+	}
+	v, ok := tlsVersions[minVersion]
+	if !ok {
+		return fmt.Errorf("unsupported min_tls_version %q", minVersion)
+	}
+	tlsConfig.MinVersion = v
+	tlsConfig.InsecureSkipVerify = cfg.InsecureSkipVerify
+
+	pemData := []byte(cfg.CACertPEM)
+	source := "ca_cert_pem"
+	if cfg.CACertFile != "" {
+		b, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return fmt.Errorf("reading ca_cert_file: %w", err)
+		}
+		pemData, source = b, "ca_cert_file"
+	}
+	if len(pemData) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pemData) {
+			return fmt.Errorf("%s does not contain any PEM encoded certificate", source)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	t.TLSClientConfig = tlsConfig
+	return nil
+}
@@ -0,0 +1,155 @@
+package cloudconnexa
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/openvpn/cloudconnexa-go-client/v2/cloudconnexa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTLSAPIServer starts an HTTPS server with a self-signed certificate and
+// returns it with its certificate as PEM.
+func newTLSAPIServer(t *testing.T, handler http.Handler) (*httptest.Server, string) {
+	t.Helper()
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, string(certPEM)
+}
+
+// newTLSTokenServer starts an HTTPS API server that only issues OAuth
+// tokens.
+func newTLSTokenServer(t *testing.T) (*httptest.Server, string) {
+	t.Helper()
+	return newTLSAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != tokenPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"tls-token"}`))
+	}))
+}
+
+// configureTestProvider runs providerConfigure with raw as the provider
+// block and explicit credentials.
+func configureTestProvider(t *testing.T, baseURL string, raw map[string]interface{}) (*cloudconnexa.Client, diag.Diagnostics) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	cfg := map[string]interface{}{
+		"base_url":      baseURL,
+		"client_id":     "id",
+		"client_secret": "secret",
+		"retry":         []interface{}{map[string]interface{}{"max_attempts": 1}},
+	}
+	for k, v := range raw {
+		cfg[k] = v
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, cfg)
+	meta, diags := providerConfigure(context.Background(), d)
+	c, _ := meta.(*cloudconnexa.Client)
+	return c, diags
+}
+
+// TestUnitProviderConfigure_CustomCA checks that the OAuth handshake honors
+// the CA settings, and that skipping verification raises a warning.
+func TestUnitProviderConfigure_CustomCA(t *testing.T) {
+	server, certPEM := newTLSTokenServer(t)
+
+	t.Run("untrusted certificate fails", func(t *testing.T) {
+		_, diags := configureTestProvider(t, server.URL, nil)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail, "certificate")
+	})
+
+	t.Run("ca_cert_pem", func(t *testing.T) {
+		c, diags := configureTestProvider(t, server.URL, map[string]interface{}{"ca_cert_pem": certPEM})
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, "tls-token", c.Token)
+		assert.Equal(t, server.URL, c.BaseURL)
+	})
+
+	t.Run("ca_cert_file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(path, []byte(certPEM), 0o600))
+		c, diags := configureTestProvider(t, server.URL, map[string]interface{}{"ca_cert_file": path})
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, "tls-token", c.Token)
+	})
+
+	t.Run("insecure_skip_verify warns", func(t *testing.T) {
+		c, diags := configureTestProvider(t, server.URL, map[string]interface{}{"insecure_skip_verify": true})
+		require.False(t, diags.HasError(), "%v", diags)
+		require.Len(t, diags, 1)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "tls-token", c.Token)
+	})
+
+	t.Run("min_tls_version above the server fails", func(t *testing.T) {
+		server := httptest.NewUnstartedServer(http.NotFoundHandler())
+		server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+		server.StartTLS()
+		t.Cleanup(server.Close)
+		_, diags := configureTestProvider(t, server.URL, map[string]interface{}{
+			"insecure_skip_verify": true,
+			"min_tls_version":      "1.3",
+		})
+		assert.True(t, diags.HasError())
+	})
+}
+
+// TestUnitTransportConfigApply covers the proxy, TLS version and CA bundle
+// settings and their validation.
+func TestUnitTransportConfigApply(t *testing.T) {
+	t.Run("proxy_url", func(t *testing.T) {
+		tr := newBaseTransport()
+		require.NoError(t, transportConfig{ProxyURL: "http://proxy.example.com:3128"}.apply(tr))
+		req, _ := http.NewRequest(http.MethodGet, "https://acme.api.openvpn.com/api/v1/networks", nil)
+		u, err := tr.Proxy(req)
+		require.NoError(t, err)
+		assert.Equal(t, "http://proxy.example.com:3128", u.String())
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		tr := newBaseTransport()
+		require.NoError(t, transportConfig{}.apply(tr))
+		assert.Equal(t, uint16(tls.VersionTLS12), tr.TLSClientConfig.MinVersion)
+		assert.False(t, tr.TLSClientConfig.InsecureSkipVerify)
+		assert.Nil(t, tr.TLSClientConfig.RootCAs)
+	})
+
+	for name, cfg := range map[string]transportConfig{
+		"proxy scheme":    {ProxyURL: "ftp://proxy.example.com"},
+		"proxy host":      {ProxyURL: "http://"},
+		"tls version":     {MinTLSVersion: "1.0"},
+		"ca pem":          {CACertPEM: "not a certificate"},
+		"missing ca file": {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, cfg.apply(newBaseTransport()))
+		})
+	}
+}
+
+// TestUnitNormalizeBaseURL checks that only HTTPS URLs without credentials
+// are accepted and that paths are dropped.
+func TestUnitNormalizeBaseURL(t *testing.T) {
+	u, err := normalizeBaseURL("https://acme.api.openvpn.com/api/v1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.api.openvpn.com", u)
+
+	for _, raw := range []string{"http://acme.api.openvpn.com", "https://", "https://user:pw@acme.api.openvpn.com", "acme"} {
+		_, err := normalizeBaseURL(raw)
+		assert.Error(t, err, raw)
+	}
+}
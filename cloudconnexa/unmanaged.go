@@ -0,0 +1,163 @@
+package cloudconnexa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// unmanagedExcludedTypes are the resource types that FindUnmanaged does not
+// check, with the reason. Every other resource type has a list resource.
+var unmanagedExcludedTypes = map[string]string{
+	"cloudconnexa_device":   "devices are registered by the VPN clients of their users, not created in the UI or with Terraform",
+	"cloudconnexa_settings": "every account has settings, so they are never created outside Terraform",
+}
+
+// UnmanagedObject is an object of the account that no Terraform state
+// manages.
+type UnmanagedObject struct {
+	Type string `json:"-"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// stateFile is the part of a Terraform state file (format version 4) needed
+// to find the IDs of managed objects.
+type stateFile struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Instances []struct {
+			Attributes struct {
+				ID string `json:"id"`
+			} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// managedIDs returns the IDs of the CloudConnexa resources in the given
+// state files, by resource type.
+func managedIDs(statePaths []string) (map[string]map[string]bool, error) {
+	ids := map[string]map[string]bool{}
+	for _, path := range statePaths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var state stateFile
+		if err := json.Unmarshal(data, &state); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if state.Version != 4 {
+			return nil, fmt.Errorf("%s: unsupported state format version %d, expected 4", path, state.Version)
+		}
+		for _, r := range state.Resources {
+			if r.Mode != "managed" || !strings.HasPrefix(r.Type, "cloudconnexa_") {
+				continue
+			}
+			if ids[r.Type] == nil {
+				ids[r.Type] = map[string]bool{}
+			}
+			for _, instance := range r.Instances {
+				ids[r.Type][instance.Attributes.ID] = true
+			}
+		}
+	}
+	return ids, nil
+}
+
+// FindUnmanaged lists the objects of the account whose IDs are absent from
+// all of the given Terraform state files, for every resource type that has a
+// list resource, except the account settings. The provider is configured as
+// for Export. Objects are sorted by type and name. A warning is written to
+// warnings for each resource type that is not checked.
+//
+// Parameters:
+//   - ctx: The context for the operation
+//   - providerConfig: Provider arguments, e.g. `cloud_id`; may be nil
+//   - statePaths: Paths of Terraform state files in format version 4
+//   - warnings: The writer for warnings, e.g. os.Stderr
+//
+// Returns:
+//   - []UnmanagedObject: The objects no state manages
+//   - error: An error if a state file could not be read or the account not listed
+func FindUnmanaged(ctx context.Context, providerConfig map[string]interface{}, statePaths []string, warnings io.Writer) ([]UnmanagedObject, error) {
+	managed, err := managedIDs(statePaths)
+	if err != nil {
+		return nil, err
+	}
+	p, err := readOnlyProvider(ctx, providerConfig)
+	if err != nil {
+		return nil, err
+	}
+	writeExcludedTypes(warnings, "not checked", unmanagedExcludedTypes)
+	var resourceTypes []string
+	for _, resourceType := range slices.Sorted(maps.Keys(listResources())) {
+		if _, excluded := unmanagedExcludedTypes[resourceType]; !excluded {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	return findUnmanaged(ctx, p, resourceTypes, managed)
+}
+
+// findUnmanaged lists the objects of the given resource types whose IDs
+// are not in managed.
+func findUnmanaged(ctx context.Context, p *schema.Provider, resourceTypes []string, managed map[string]map[string]bool) ([]UnmanagedObject, error) {
+	c := clientFromMeta(ctx, p.Meta())
+	lists := listResources()
+	var objects []UnmanagedObject
+	for _, resourceType := range resourceTypes {
+		l := lists[resourceType]
+		items, err := l.list(ctx, c, "")
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", resourceType, err)
+		}
+		slices.SortStableFunc(items, func(a, b listItem) int { return strings.Compare(a.displayName, b.displayName) })
+		for _, item := range items {
+			if !managed[resourceType][item.id] {
+				objects = append(objects, UnmanagedObject{Type: resourceType, ID: item.id, Name: item.displayName})
+			}
+		}
+	}
+	return objects, nil
+}
+
+// WriteUnmanaged writes objects to w as a table or, with format `json`, as
+// a JSON object of arrays by resource type.
+//
+// Parameters:
+//   - w: The writer to write to
+//   - objects: The objects returned by FindUnmanaged
+//   - format: `table` or `json`
+//
+// Returns:
+//   - error: An error if the format is unknown or writing failed
+func WriteUnmanaged(w io.Writer, objects []UnmanagedObject, format string) error {
+	switch format {
+	case "json":
+		byType := map[string][]UnmanagedObject{}
+		for _, o := range objects {
+			byType[o.Type] = append(byType[o.Type], o)
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(byType)
+	case "table":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "TYPE\tID\tNAME")
+		for _, o := range objects {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", o.Type, o.ID, o.Name)
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown format %q, expected table or json", format)
+}
@@ -0,0 +1,116 @@
+package cloudconnexa
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// writeTestState writes a state file to a temporary directory and returns
+// its path.
+func writeTestState(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "terraform.tfstate")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// TestUnitManagedIDs checks that managed CloudConnexa resources of all
+// state files are collected, and data sources and other providers ignored.
+func TestUnitManagedIDs(t *testing.T) {
+	first := writeTestState(t, `{"version":4,"resources":[
+		{"mode":"managed","type":"cloudconnexa_host","instances":[{"attributes":{"id":"host-1"}}]},
+		{"mode":"data","type":"cloudconnexa_host_connector","instances":[{"attributes":{"id":"connector-1"}}]},
+		{"mode":"managed","type":"aws_instance","instances":[{"attributes":{"id":"i-1"}}]}
+	]}`)
+	second := writeTestState(t, `{"version":4,"resources":[
+		{"mode":"managed","type":"cloudconnexa_host","instances":[{"attributes":{"id":"host-2"}}]}
+	]}`)
+
+	ids, err := managedIDs([]string{first, second})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]bool{
+		"cloudconnexa_host": {"host-1": true, "host-2": true},
+	}, ids)
+
+	_, err = managedIDs([]string{writeTestState(t, `{"version":3}`)})
+	assert.ErrorContains(t, err, "unsupported state format version 3")
+	_, err = managedIDs([]string{filepath.Join(t.TempDir(), "missing.tfstate")})
+	assert.Error(t, err)
+}
+
+// TestUnitFindUnmanaged checks that only objects absent from the state are
+// reported, in both output formats.
+func TestUnitFindUnmanaged(t *testing.T) {
+	c := newHostUnitTestClient(t, exportTestHandler())
+	c.ReadRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	p := Provider()
+	p.SetMeta(c)
+
+	objects, err := findUnmanaged(context.Background(), p, []string{"cloudconnexa_host", "cloudconnexa_host_connector"}, map[string]map[string]bool{
+		"cloudconnexa_host": {"host-1": true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []UnmanagedObject{{Type: "cloudconnexa_host_connector", ID: "connector-1", Name: "web-1"}}, objects)
+
+	var out bytes.Buffer
+	require.NoError(t, WriteUnmanaged(&out, objects, "table"))
+	assert.Equal(t, "TYPE                         ID           NAME\ncloudconnexa_host_connector  connector-1  web-1\n", out.String())
+
+	out.Reset()
+	require.NoError(t, WriteUnmanaged(&out, objects, "json"))
+	assert.JSONEq(t, `{"cloudconnexa_host_connector":[{"id":"connector-1","name":"web-1"}]}`, out.String())
+
+	assert.Error(t, WriteUnmanaged(&out, objects, "yaml"))
+}
+
+// TestUnitFindUnmanaged_Coverage checks that every resource type is either
+// checked through its list resource or excluded with a reason.
+func TestUnitFindUnmanaged_Coverage(t *testing.T) {
+	p := Provider()
+	lists := listResources()
+	resourceTypes := slices.Concat(slices.Collect(maps.Keys(p.ResourcesMap)), slices.Collect(maps.Keys(frameworkResources())))
+	for _, resourceType := range resourceTypes {
+		_, listed := lists[resourceType]
+		_, excluded := unmanagedExcludedTypes[resourceType]
+		assert.True(t, listed || excluded, "%s must either have a list resource or be excluded from find-unmanaged", resourceType)
+	}
+	for resourceType := range unmanagedExcludedTypes {
+		assert.Contains(t, resourceTypes, resourceType)
+	}
+}
+
+// TestUnitFindUnmanaged_Account checks FindUnmanaged against the fake API:
+// objects of the account are reported, except the account settings, and
+// excluded resource types are reported as warnings.
+func TestUnitFindUnmanaged_Account(t *testing.T) {
+	_, config := newFakeAPI(t, fakeapi.Options{})
+	p := Provider()
+	require.False(t, p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)).HasError())
+	ctx := context.Background()
+	userGroup := p.ResourcesMap["cloudconnexa_user_group"]
+	d := schema.TestResourceDataRaw(t, userGroup.Schema, map[string]interface{}{"name": "Staff"})
+	require.False(t, userGroup.CreateContext(ctx, d, p.Meta()).HasError())
+
+	var warnings bytes.Buffer
+	objects, err := FindUnmanaged(ctx, config, []string{writeTestState(t, `{"version":4,"resources":[]}`)}, &warnings)
+	require.NoError(t, err)
+
+	assert.Contains(t, objects, UnmanagedObject{Type: "cloudconnexa_user_group", ID: d.Id(), Name: "Staff"})
+	for _, o := range objects {
+		assert.NotEqual(t, "cloudconnexa_settings", o.Type)
+	}
+	assert.Equal(t, "Warning: cloudconnexa_device is not checked: "+unmanagedExcludedTypes["cloudconnexa_device"]+".\n"+
+		"Warning: cloudconnexa_settings is not checked: "+unmanagedExcludedTypes["cloudconnexa_settings"]+".\n", warnings.String())
+}
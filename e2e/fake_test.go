@@ -0,0 +1,75 @@
+package e2e
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/cloudconnexa"
+	"github.com/OpenVPN/terraform-provider-cloudconnexa/internal/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+// FakeAPIEnvVar is the environment variable that runs the end-to-end test
+// against an in-memory fake of the CloudConnexa API when set to "1".
+const FakeAPIEnvVar = "TF_ACC_FAKE"
+
+// providerAddress is the source address of the provider in ./setup.
+const providerAddress = "cloudconnexa.dev/openvpn/cloudconnexa"
+
+// setupFakeAPI starts the fake CloudConnexa API for the duration of t and
+// prepares a Terraform configuration that talks to it. The provider is built
+// from this repository and installed through a `dev_overrides` CLI
+// configuration, so no registry or network access is needed. Only
+// ./setup/main.tf is used: the EC2 instance running the connector is
+// replaced by the fake reporting connectors online after a moment.
+//
+// Parameters:
+//   - t: The testing context
+//
+// Returns:
+//   - string: The directory holding the Terraform configuration
+func setupFakeAPI(t *testing.T) string {
+	t.Helper()
+	const clientID, clientSecret = "fake-client-id", "fake-client-secret"
+	srv := fakeapi.New(fakeapi.Options{
+		ClientID:             clientID,
+		ClientSecret:         clientSecret,
+		ConnectorOnlineAfter: 5 * time.Second,
+	})
+	t.Cleanup(srv.Close)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, srv.WriteCACert(caFile))
+
+	pluginDir := filepath.Join(dir, "plugins")
+	build := exec.Command("go", "build", "-o", filepath.Join(pluginDir, "terraform-provider-cloudconnexa"), "..")
+	build.Stdout, build.Stderr = os.Stdout, os.Stderr
+	require.NoError(t, build.Run(), "building the provider")
+
+	cliConfig := filepath.Join(dir, "terraformrc")
+	require.NoError(t, os.WriteFile(cliConfig, []byte(fmt.Sprintf(`provider_installation {
+  dev_overrides {
+    %q = %q
+  }
+  direct {}
+}
+`, providerAddress, pluginDir)), 0o600))
+
+	tfDir := filepath.Join(dir, "setup")
+	require.NoError(t, os.Mkdir(tfDir, 0o700))
+	config, err := os.ReadFile(filepath.Join("setup", "main.tf"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tfDir, "main.tf"), config, 0o600))
+
+	t.Setenv("TF_CLI_CONFIG_FILE", cliConfig)
+	t.Setenv(CloudConnexaHostKey, srv.URL)
+	t.Setenv(cloudconnexa.ClientIDEnvVar, clientID)
+	t.Setenv(cloudconnexa.ClientSecretEnvVar, clientSecret)
+	t.Setenv(cloudconnexa.CACertFileEnvVar, caFile)
+	return tfDir
+}
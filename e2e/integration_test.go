@@ -2,7 +2,10 @@ package e2e
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -21,10 +24,15 @@ const (
 
 // TestCreationDeletion tests the creation and deletion of CloudConnexa resources.
 // It verifies that a connector can be created and becomes online within the expected timeframe.
+// With TF_ACC_FAKE=1 it runs against an in-memory fake of the API instead.
 //
 // Parameters:
 //   - t: The testing context
 func TestCreationDeletion(t *testing.T) {
+	terraformDir := "./setup"
+	if os.Getenv(FakeAPIEnvVar) == "1" {
+		terraformDir = setupFakeAPI(t)
+	}
 	validateEnvVars(t)
 
 	ctx := context.Background()
@@ -33,10 +41,12 @@ func TestCreationDeletion(t *testing.T) {
 		NoColor: os.Getenv("NO_COLOR") == "1",
 
 		// The path to where our Terraform code is located
-		TerraformDir: "./setup",
+		TerraformDir: terraformDir,
 
 		// Variables to pass to our Terraform code using -var options
-		Vars: map[string]interface{}{},
+		Vars: map[string]interface{}{
+			"base_url": os.Getenv(CloudConnexaHostKey),
+		},
 	}
 
 	// At the end of the test, run `terraform destroy` to clean up any resources that were created
@@ -54,12 +64,7 @@ func TestCreationDeletion(t *testing.T) {
 	assert.NotEmpty(t, hostID)
 	assert.NotEmpty(t, connectorID)
 
-	client, err := api.NewClient(
-		os.Getenv(CloudConnexaHostKey),
-		os.Getenv(cloudconnexa.ClientIDEnvVar),
-		os.Getenv(cloudconnexa.ClientSecretEnvVar),
-	)
-	require.NoError(t, err)
+	client := newAPIClient(t)
 
 	// Total waiting time: 1min
 	totalAttempts := 10
@@ -68,7 +73,7 @@ func TestCreationDeletion(t *testing.T) {
 	connectorWasOnline := false
 	for i := 0; i < totalAttempts; i++ {
 		t.Logf("Waiting for connector to be online (%d/%d)", i+1, totalAttempts)
-		connector, err := client.NetworkConnectors.GetByID(connectorID)
+		connector, err := client.HostConnectors.GetByID(connectorID)
 		require.NoError(t, err, "Invalid connector ID in output")
 		if connector.ConnectionStatus == "online" {
 			connectorWasOnline = true
@@ -79,6 +84,40 @@ func TestCreationDeletion(t *testing.T) {
 	assert.True(t, connectorWasOnline)
 }
 
+// newAPIClient returns a client for the API the test runs against. Like the
+// provider, it trusts the CA certificates of CLOUDCONNEXA_CA_CERT_FILE, if
+// set, in addition to the system roots.
+//
+// Parameters:
+//   - t: The testing context
+//
+// Returns:
+//   - *api.Client: The client
+func newAPIClient(t *testing.T) *api.Client {
+	t.Helper()
+	var opts *api.ClientOptions
+	if caFile := os.Getenv(cloudconnexa.CACertFileEnvVar); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		require.NoError(t, err)
+		roots, err := x509.SystemCertPool()
+		if err != nil {
+			roots = x509.NewCertPool()
+		}
+		require.True(t, roots.AppendCertsFromPEM(pem), "no certificates in %s", caFile)
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		tr.TLSClientConfig = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+		opts = &api.ClientOptions{HTTPClient: &http.Client{Transport: tr, Timeout: 30 * time.Second}}
+	}
+	client, err := api.NewClientWithOptions(
+		os.Getenv(CloudConnexaHostKey),
+		os.Getenv(cloudconnexa.ClientIDEnvVar),
+		os.Getenv(cloudconnexa.ClientSecretEnvVar),
+		opts,
+	)
+	require.NoError(t, err)
+	return client
+}
+
 // validateEnvVars checks that all required environment variables are set.
 // It validates the CloudConnexa host, client ID, and client secret environment variables.
 //
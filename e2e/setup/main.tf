@@ -8,7 +8,11 @@ terraform {
 }
 
 provider "cloudconnexa" {
-  base_url = ""
+  base_url = var.base_url
+}
+
+variable "base_url" {
+  type = string
 }
 
 variable "host_name" {
@@ -21,16 +25,19 @@ resource "cloudconnexa_host" "host" {
   description     = "Terraform test description 2"
   internet_access = "SPLIT_TUNNEL_ON"
 
-  connector {
-    name          = "test"
-    vpn_region_id = "us-west-1"
-  }
+  provider = cloudconnexa
+}
+
+resource "cloudconnexa_host_connector" "connector" {
+  name          = "test"
+  vpn_region_id = "us-west-1"
+  host_id       = cloudconnexa_host.host.id
 
   provider = cloudconnexa
 }
 
 locals {
-  connector_profile = [for connector in cloudconnexa_host.host.connector : connector.profile][0]
+  connector_profile = cloudconnexa_host_connector.connector.profile
 }
 
 
@@ -39,5 +46,5 @@ output "host_id" {
 }
 
 output "connector_id" {
-  value = [for connector in cloudconnexa_host.host.connector : connector.id][0]
+  value = cloudconnexa_host_connector.connector.id
 }
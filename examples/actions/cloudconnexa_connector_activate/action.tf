@@ -0,0 +1,8 @@
+# Bring a suspended connector back with
+# `terraform apply -invoke=action.cloudconnexa_connector_activate.branch`.
+action "cloudconnexa_connector_activate" "branch" {
+  config {
+    connector_type = "NETWORK"
+    id             = cloudconnexa_network_connector.branch.id
+  }
+}
@@ -0,0 +1,18 @@
+action "cloudconnexa_connector_restart_ipsec" "aws" {
+  config {
+    id = cloudconnexa_network_connector.aws.id
+  }
+}
+
+# Restart the tunnel whenever the AWS side of it is replaced. It can also be
+# run on demand with `terraform apply -invoke=action.cloudconnexa_connector_restart_ipsec.aws`.
+resource "aws_vpn_connection" "cloudconnexa" {
+  # ...
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.cloudconnexa_connector_restart_ipsec.aws]
+    }
+  }
+}
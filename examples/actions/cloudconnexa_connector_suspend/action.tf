@@ -0,0 +1,8 @@
+# Take a connector offline during an incident with
+# `terraform apply -invoke=action.cloudconnexa_connector_suspend.branch`.
+action "cloudconnexa_connector_suspend" "branch" {
+  config {
+    connector_type = "NETWORK"
+    id             = cloudconnexa_network_connector.branch.id
+  }
+}
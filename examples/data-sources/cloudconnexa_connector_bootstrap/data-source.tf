@@ -0,0 +1,24 @@
+# Run a network connector on an EC2 instance through cloud-init
+data "cloudconnexa_connector_bootstrap" "office" {
+  network_connector_id = cloudconnexa_network_connector.office.id
+  format               = "cloud-init"
+}
+
+resource "aws_instance" "connector" {
+  ami           = data.aws_ami.ubuntu.id
+  instance_type = "t3.micro"
+  user_data     = data.cloudconnexa_connector_bootstrap.office.content
+}
+
+# Run a host connector in Kubernetes
+data "cloudconnexa_connector_bootstrap" "cluster" {
+  host_connector_id = cloudconnexa_host_connector.cluster.id
+  format            = "kubernetes"
+  namespace         = "vpn"
+  image             = "registry.example.com/openvpn:2.6"
+}
+
+resource "local_sensitive_file" "manifest" {
+  filename = "${path.module}/connector.yaml"
+  content  = data.cloudconnexa_connector_bootstrap.cluster.content
+}
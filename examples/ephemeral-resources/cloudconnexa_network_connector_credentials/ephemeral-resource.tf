@@ -0,0 +1,19 @@
+resource "cloudconnexa_network_connector" "example" {
+  name             = "example-connector"
+  network_id       = cloudconnexa_network.example.id
+  vpn_region_id    = "us-east-1"
+  omit_credentials = true
+}
+
+# The token and profile are fetched on each run and never stored in plan or
+# state. Here the profile is written to AWS Secrets Manager via a write-only
+# argument for the connector host to pick up.
+ephemeral "cloudconnexa_network_connector_credentials" "example" {
+  id = cloudconnexa_network_connector.example.id
+}
+
+resource "aws_secretsmanager_secret_version" "connector_profile" {
+  secret_id                = aws_secretsmanager_secret.connector_profile.id
+  secret_string_wo         = ephemeral.cloudconnexa_network_connector_credentials.example.profile
+  secret_string_wo_version = 1
+}
@@ -0,0 +1,20 @@
+variable "route_subnets" {
+  type = list(string)
+}
+
+resource "cloudconnexa_route" "example" {
+  for_each = toset(var.route_subnets)
+
+  network_item_id = cloudconnexa_network.example.id
+  type            = "IP_V4"
+  subnet          = each.value
+
+  lifecycle {
+    precondition {
+      condition = !anytrue([
+        for s in cloudconnexa_network.example.system_subnets : provider::cloudconnexa::cidr_overlaps(each.value, s)
+      ])
+      error_message = "Route ${each.value} overlaps the network's system subnets."
+    }
+  }
+}
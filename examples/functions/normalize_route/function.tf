@@ -0,0 +1,7 @@
+# Compare configured subnets with the canonical form the API returns, e.g.
+# "10.1.2.3/16" becomes "10.1.0.0/16".
+resource "cloudconnexa_route" "example" {
+  network_item_id = cloudconnexa_network.example.id
+  type            = "IP_V4"
+  subnet          = provider::cloudconnexa::normalize_route(var.subnet)
+}
@@ -0,0 +1,19 @@
+locals {
+  connector = provider::cloudconnexa::parse_profile(cloudconnexa_network_connector.example.profile)
+}
+
+# Open the connector's ports in the firewall in front of its host.
+resource "aws_security_group_rule" "connector_egress" {
+  for_each = toset([for r in local.connector.remotes : "${r.protocol}/${r.port}"])
+
+  type              = "egress"
+  security_group_id = aws_security_group.connector.id
+  protocol          = split("/", each.value)[0]
+  from_port         = tonumber(split("/", each.value)[1])
+  to_port           = tonumber(split("/", each.value)[1])
+  cidr_blocks       = ["0.0.0.0/0"]
+}
+
+output "connector_ca_fingerprint" {
+  value = local.connector.ca_fingerprint
+}
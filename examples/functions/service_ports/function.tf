@@ -0,0 +1,12 @@
+# Mirror a predefined service type in a cloud firewall, e.g. SSH becomes
+# [{ protocol = "TCP", from_port = 22, to_port = 22 }].
+resource "aws_security_group_rule" "ssh" {
+  for_each = { for p in provider::cloudconnexa::service_ports("SSH") : p.protocol => p }
+
+  type              = "ingress"
+  security_group_id = aws_security_group.connector.id
+  protocol          = lower(each.value.protocol)
+  from_port         = each.value.from_port
+  to_port           = each.value.to_port
+  cidr_blocks       = ["100.96.0.0/11"]
+}
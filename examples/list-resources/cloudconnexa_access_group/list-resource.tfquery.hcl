@@ -0,0 +1,5 @@
+# Run `terraform query -generate-config-out=generated.tf` to write import
+# blocks and configuration for every result.
+list "cloudconnexa_access_group" "all" {
+  provider = cloudconnexa
+}
@@ -0,0 +1,9 @@
+# Run `terraform query -generate-config-out=generated.tf` to write import
+# blocks and configuration for every result.
+list "cloudconnexa_host_connector" "example" {
+  provider = cloudconnexa
+
+  config {
+    host_id = var.host_id
+  }
+}
@@ -0,0 +1,9 @@
+# Run `terraform query -generate-config-out=generated.tf` to write import
+# blocks and configuration for every result.
+list "cloudconnexa_network_connector" "example" {
+  provider = cloudconnexa
+
+  config {
+    network_id = var.network_id
+  }
+}
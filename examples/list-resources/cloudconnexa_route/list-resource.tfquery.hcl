@@ -0,0 +1,9 @@
+# Run `terraform query -generate-config-out=generated.tf` to write import
+# blocks and configuration for every result.
+list "cloudconnexa_route" "example" {
+  provider = cloudconnexa
+
+  config {
+    network_item_id = var.network_id
+  }
+}
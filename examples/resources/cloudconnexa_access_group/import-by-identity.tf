@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_access_group.example
+  identity = {
+    id = "<id>"
+  }
+}
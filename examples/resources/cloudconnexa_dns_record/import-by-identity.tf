@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_dns_record.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_host.example
+  identity = {
+    id = "<id>"
+  }
+}
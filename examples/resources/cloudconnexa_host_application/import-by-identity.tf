@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_host_application.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_host_connector.example
+  identity = {
+    id = "<id>"
+  }
+}
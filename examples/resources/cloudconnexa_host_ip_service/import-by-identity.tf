@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_host_ip_service.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_location_context.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_network.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_network_application.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_network_connector.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_network_ip_service.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_route.example
+  identity = {
+    id = "<id>"
+  }
+}
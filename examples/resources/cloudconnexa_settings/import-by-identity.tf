@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_settings.example
+  identity = {
+    id = "settings"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_user.example
+  identity = {
+    id = "<id>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = cloudconnexa_user_group.example
+  identity = {
+    id = "<id>"
+  }
+}
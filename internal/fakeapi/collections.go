@@ -0,0 +1,614 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultPageSize is the page size of list responses without `size`.
+const defaultPageSize = 10
+
+// IPsec tunnel states reported in `ipSecConfig.connectorState`.
+const (
+	IPsecStarted = "STARTED"
+	IPsecStopped = "STOPPED"
+)
+
+// object is a stored API object.
+type object struct {
+	data      map[string]any
+	created   time.Time
+	suspended bool
+	ipsec     string
+}
+
+// action handles a request to a sub-path of an object, e.g.
+// `POST /networks/connectors/{id}/profile`.
+type action func(s *Server, o *object, query url.Values) (status int, response any)
+
+// collection is an API object type with CRUD endpoints below its path.
+type collection struct {
+	// path is the collection path below /api/v1, e.g. `hosts/connectors`.
+	path string
+	// parentParam is the query parameter naming the parent object on
+	// create and in list filters, e.g. `hostId`.
+	parentParam string
+	// parentField is the object field holding the parent ID, e.g.
+	// `networkItemId`.
+	parentField string
+	// parent is the path of the parent collection.
+	parent string
+	// itemType is stored as `networkItemType`, e.g. `HOST`.
+	itemType string
+	// serverFields are generated by the server and kept on update.
+	serverFields []string
+	// prepare turns a request body into the stored object; old is nil on
+	// create.
+	prepare func(s *Server, data map[string]any, old *object)
+	// view adds computed fields to a copy of the stored object.
+	view func(s *Server, o *object, data map[string]any)
+	// actions by method and sub-path, e.g. `PUT suspend`.
+	actions map[string]action
+	// children are the collections whose objects are deleted with an
+	// object of this collection.
+	children []string
+
+	ids     []string
+	objects map[string]*object
+}
+
+// newCollections returns the empty collections of the API by path.
+func newCollections() map[string]*collection {
+	connectorActions := map[string]action{
+		"POST profile":         connectorProfile,
+		"POST profile/encrypt": connectorToken,
+		"PUT activate":         setSuspended(false),
+		"PUT suspend":          setSuspended(true),
+	}
+	networkConnectorActions := maps.Clone(connectorActions)
+	networkConnectorActions["POST ipsec/start"] = setIPsec(IPsecStarted)
+	networkConnectorActions["POST ipsec/stop"] = setIPsec(IPsecStopped)
+
+	list := []*collection{
+		{
+			path:         "networks",
+			serverFields: []string{"systemSubnets"},
+			prepare:      prepareNetworkItem,
+			view:         embedChildren("networks/connectors", "connectors", "networks/routes", "routes"),
+			children:     []string{"networks/connectors", "networks/routes", "networks/ip-services", "networks/applications"},
+		},
+		{
+			path:         "hosts",
+			serverFields: []string{"systemSubnets"},
+			prepare:      prepareNetworkItem,
+			view:         embedChildren("hosts/connectors", "connectors"),
+			children:     []string{"hosts/connectors", "hosts/ip-services", "hosts/applications"},
+		},
+		{
+			path:         "networks/connectors",
+			parentParam:  "networkId",
+			parentField:  "networkItemId",
+			parent:       "networks",
+			itemType:     "NETWORK",
+			serverFields: []string{"ipV4Address", "ipV6Address"},
+			prepare:      prepareConnector,
+			view:         viewConnector,
+			actions:      networkConnectorActions,
+		},
+		{
+			path:         "hosts/connectors",
+			parentParam:  "hostId",
+			parentField:  "networkItemId",
+			parent:       "hosts",
+			itemType:     "HOST",
+			serverFields: []string{"ipV4Address", "ipV6Address"},
+			prepare:      prepareConnector,
+			view:         viewConnector,
+			actions:      connectorActions,
+		},
+		{
+			path:        "networks/routes",
+			parentParam: "networkId",
+			parentField: "networkItemId",
+			parent:      "networks",
+			prepare:     prepareRoute,
+		},
+		{
+			path:        "networks/ip-services",
+			parentParam: "networkId",
+			parentField: "networkItemId",
+			parent:      "networks",
+			itemType:    "NETWORK",
+			prepare:     prepareIPService,
+		},
+		{
+			path:        "hosts/ip-services",
+			parentParam: "hostId",
+			parentField: "networkItemId",
+			parent:      "hosts",
+			itemType:    "HOST",
+			prepare:     prepareIPService,
+		},
+		{
+			path:        "networks/applications",
+			parentParam: "networkId",
+			parentField: "networkItemId",
+			parent:      "networks",
+			itemType:    "NETWORK",
+			prepare:     prepareApplication,
+		},
+		{
+			path:        "hosts/applications",
+			parentParam: "hostId",
+			parentField: "networkItemId",
+			parent:      "hosts",
+			itemType:    "HOST",
+			prepare:     prepareApplication,
+		},
+		{
+			path:     "users",
+			prepare:  prepareUser,
+			view:     viewUser,
+			actions:  map[string]action{"PUT activate": setUserStatus("ACTIVE"), "PUT suspend": setUserStatus("SUSPENDED")},
+			children: []string{"devices"},
+		},
+		{
+			path:         "devices",
+			parentParam:  "userId",
+			parentField:  "userId",
+			parent:       "users",
+			serverFields: []string{"clientUUID", "platform", "ipV4Address", "ipV6Address"},
+			prepare:      prepareDevice,
+			actions: map[string]action{
+				"POST profile":   deviceProfile,
+				"DELETE profile": noContent,
+			},
+		},
+		{path: "user-groups"},
+		{path: "dns-records"},
+		{path: "access-groups"},
+		{path: "location-contexts"},
+	}
+	collections := map[string]*collection{}
+	for _, c := range list {
+		c.objects = map[string]*object{}
+		collections[c.path] = c
+	}
+	return collections
+}
+
+// serveCollection serves a request to c. rest holds the path segments
+// after the collection path. s.mu must be held.
+func (s *Server) serveCollection(w http.ResponseWriter, c *collection, method string, rest []string, query url.Values, body []byte) {
+	if len(rest) == 0 {
+		switch method {
+		case http.MethodGet:
+			s.list(w, c, query)
+		case http.MethodPost:
+			s.create(w, c, query, body)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+
+	o := s.visible(c, rest[0])
+	if o == nil || (c.parentParam != "" && query.Has(c.parentParam) && query.Get(c.parentParam) != o.data[c.parentField]) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.path, rest[0]))
+		return
+	}
+	if len(rest) > 1 {
+		a, ok := c.actions[method+" "+strings.Join(rest[1:], "/")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "no such endpoint")
+			return
+		}
+		status, response := a(s, o, query)
+		switch v := response.(type) {
+		case nil:
+			w.WriteHeader(status)
+		case string:
+			writeText(w, status, v)
+		default:
+			writeJSON(w, status, v)
+		}
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.render(c, o))
+	case http.MethodPut:
+		data, err := decodeObject(body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, field := range append([]string{"id", c.parentField, "networkItemType"}, c.serverFields...) {
+			if v, ok := o.data[field]; ok {
+				data[field] = v
+			}
+		}
+		if c.prepare != nil {
+			c.prepare(s, data, o)
+		}
+		o.data = data
+		writeJSON(w, http.StatusOK, s.render(c, o))
+	case http.MethodDelete:
+		s.delete(c, o.data["id"].(string))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// list serves a page of the visible objects of c, filtered by the parent
+// query parameter when present.
+func (s *Server) list(w http.ResponseWriter, c *collection, query url.Values) {
+	var items []any
+	for _, id := range c.ids {
+		o := s.visible(c, id)
+		if o == nil {
+			continue
+		}
+		if c.parentParam != "" && query.Get(c.parentParam) != "" && query.Get(c.parentParam) != o.data[c.parentField] {
+			continue
+		}
+		items = append(items, s.render(c, o))
+	}
+	writeJSON(w, http.StatusOK, page(items, query))
+}
+
+// create stores the object in body as a new object of c.
+func (s *Server) create(w http.ResponseWriter, c *collection, query url.Values, body []byte) {
+	data, err := decodeObject(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if c.parentParam != "" {
+		parentID := query.Get(c.parentParam)
+		if parentID == "" {
+			writeError(w, http.StatusBadRequest, c.parentParam+" is required")
+			return
+		}
+		if _, ok := s.collections[c.parent].objects[parentID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", c.parent, parentID))
+			return
+		}
+		data[c.parentField] = parentID
+	}
+	if region, ok := data["vpnRegionId"].(string); ok && !knownRegion(region) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown VPN region %q", region))
+		return
+	}
+	data["id"] = s.newID()
+	if c.itemType != "" {
+		data["networkItemType"] = c.itemType
+	}
+	if c.prepare != nil {
+		c.prepare(s, data, nil)
+	}
+	s.insert(c, data)
+	writeJSON(w, http.StatusCreated, s.render(c, c.objects[data["id"].(string)]))
+}
+
+// insert stores data, which must have an `id`, in c.
+func (s *Server) insert(c *collection, data map[string]any) *object {
+	id := data["id"].(string)
+	o := &object{data: data, created: time.Now()}
+	c.ids = append(c.ids, id)
+	c.objects[id] = o
+	return o
+}
+
+// delete removes the object id from c together with its children.
+func (s *Server) delete(c *collection, id string) {
+	for _, childPath := range c.children {
+		child := s.collections[childPath]
+		for _, childID := range append([]string(nil), child.ids...) {
+			if child.objects[childID].data[child.parentField] == id {
+				s.delete(child, childID)
+			}
+		}
+	}
+	delete(c.objects, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// visible returns the object id of c if it exists and the consistency lag
+// since its creation has passed.
+func (s *Server) visible(c *collection, id string) *object {
+	o, ok := c.objects[id]
+	if !ok || time.Since(o.created) < s.opts.ConsistencyLag {
+		return nil
+	}
+	return o
+}
+
+// render returns the API representation of o.
+func (s *Server) render(c *collection, o *object) map[string]any {
+	data := maps.Clone(o.data)
+	if c.view != nil {
+		c.view(s, o, data)
+	}
+	return data
+}
+
+// children returns the rendered objects of the collection at path whose
+// parent is parentID.
+func (s *Server) children(path, parentID string) []any {
+	c := s.collections[path]
+	items := []any{}
+	for _, id := range c.ids {
+		if o := s.visible(c, id); o != nil && o.data[c.parentField] == parentID {
+			items = append(items, s.render(c, o))
+		}
+	}
+	return items
+}
+
+// page returns the page of items selected by the `page` and `size` query
+// parameters, in the page format of the API.
+func page(items []any, query url.Values) map[string]any {
+	number, _ := strconv.Atoi(query.Get("page"))
+	size, _ := strconv.Atoi(query.Get("size"))
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	start := min(number*size, len(items))
+	end := min(start+size, len(items))
+	content := append([]any{}, items[start:end]...)
+	return map[string]any{
+		"content":          content,
+		"numberOfElements": len(content),
+		"page":             number,
+		"size":             size,
+		"success":          true,
+		"totalElements":    len(items),
+		"totalPages":       (len(items) + size - 1) / size,
+	}
+}
+
+// decodeObject decodes a JSON object request body.
+func decodeObject(body []byte) (map[string]any, error) {
+	data := map[string]any{}
+	if len(body) == 0 {
+		return data, nil
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	return data, nil
+}
+
+// prepareNetworkItem assigns system subnets to new networks and hosts.
+func prepareNetworkItem(s *Server, data map[string]any, old *object) {
+	if old == nil {
+		data["systemSubnets"] = []any{
+			fmt.Sprintf("100.80.%d.0/24", s.nextID%256),
+			fmt.Sprintf("fd00:a:b:%x::/64", s.nextID),
+		}
+	}
+	if data["internetAccess"] == nil || data["internetAccess"] == "" {
+		data["internetAccess"] = "SPLIT_TUNNEL_ON"
+	}
+	if _, ok := data["tunnelingProtocol"]; ok && data["tunnelingProtocol"] == "" {
+		data["tunnelingProtocol"] = "OPENVPN"
+	}
+}
+
+// embedChildren returns a view that embeds the children of the collections
+// at the given paths under the given field names, as pairs.
+func embedChildren(pathsAndFields ...string) func(*Server, *object, map[string]any) {
+	return func(s *Server, o *object, data map[string]any) {
+		for i := 0; i+1 < len(pathsAndFields); i += 2 {
+			data[pathsAndFields[i+1]] = s.children(pathsAndFields[i], o.data["id"].(string))
+		}
+	}
+}
+
+// prepareConnector assigns tunnel addresses to new connectors and defaults
+// the tunneling protocol.
+func prepareConnector(s *Server, data map[string]any, old *object) {
+	if old == nil {
+		data["ipV4Address"] = fmt.Sprintf("100.96.%d.%d", s.nextID/256%256, s.nextID%256)
+		data["ipV6Address"] = fmt.Sprintf("fd00:a:b:c::%x", s.nextID)
+	}
+	if p, _ := data["tunnelingProtocol"].(string); p == "" {
+		data["tunnelingProtocol"] = "OPENVPN"
+		if data["ipSecConfig"] != nil {
+			data["tunnelingProtocol"] = "IPSEC"
+		}
+	}
+	delete(data, "profile")
+	delete(data, "connectionStatus")
+}
+
+// viewConnector adds the connection status and, for IPsec connectors, the
+// tunnel state and server address.
+func viewConnector(s *Server, o *object, data map[string]any) {
+	data["connectionStatus"] = "offline"
+	if !o.suspended && s.opts.ConnectorOnlineAfter > 0 && time.Since(o.created) >= s.opts.ConnectorOnlineAfter {
+		data["connectionStatus"] = "online"
+	}
+	data["licensed"] = !o.suspended
+	if cfg, ok := data["ipSecConfig"].(map[string]any); ok {
+		cfg = maps.Clone(cfg)
+		cfg["connectorState"] = IPsecStopped
+		if o.ipsec != "" {
+			cfg["connectorState"] = o.ipsec
+		}
+		// The public address of the CloudConnexa end of the tunnel, from
+		// the documentation range.
+		id, _ := data["id"].(string)
+		cfg["serverIp"] = fmt.Sprintf("198.51.100.%d", crc32.ChecksumIEEE([]byte(id))%254+1)
+		data["ipSecConfig"] = cfg
+	}
+}
+
+// connectorProfile returns the OpenVPN profile of a connector.
+func connectorProfile(_ *Server, o *object, _ url.Values) (int, any) {
+	region, _ := o.data["vpnRegionId"].(string)
+	if region == "" {
+		region = "us-east-1"
+	}
+	return http.StatusOK, fmt.Sprintf(
+		"client\ndev tun\nremote %[1]s.fake.openvpn.com 1194 udp\nremote %[1]s.fake.openvpn.com 443 tcp\ncipher AES-256-GCM\n# connector %[2]s\n",
+		region, o.data["id"])
+}
+
+// connectorToken returns the encrypted profile token of a connector.
+func connectorToken(_ *Server, o *object, _ url.Values) (int, any) {
+	return http.StatusOK, "fake-connector-token-" + o.data["id"].(string)
+}
+
+// noContent is an action without effect on the fake's state, e.g. revoking
+// a device profile.
+func noContent(*Server, *object, url.Values) (int, any) {
+	return http.StatusNoContent, nil
+}
+
+// setSuspended returns an action that suspends or activates a connector.
+func setSuspended(suspended bool) action {
+	return func(_ *Server, o *object, _ url.Values) (int, any) {
+		o.suspended = suspended
+		return http.StatusNoContent, nil
+	}
+}
+
+// setIPsec returns an action that starts or stops the IPsec tunnel of a
+// connector.
+func setIPsec(state string) action {
+	return func(_ *Server, o *object, _ url.Values) (int, any) {
+		if o.data["ipSecConfig"] == nil {
+			return http.StatusBadRequest, map[string]any{"status": http.StatusBadRequest, "message": "connector has no IPsec configuration"}
+		}
+		o.ipsec = state
+		return http.StatusNoContent, nil
+	}
+}
+
+// prepareRoute turns the `value` of a route request into the stored
+// `subnet` and derives the route type.
+func prepareRoute(_ *Server, data map[string]any, _ *object) {
+	if v, ok := data["value"].(string); ok {
+		data["subnet"] = v
+		delete(data, "value")
+	}
+	subnet, _ := data["subnet"].(string)
+	data["type"] = "IP_V4"
+	if strings.Contains(subnet, ":") {
+		data["type"] = "IP_V6"
+	}
+}
+
+// prepareIPService turns the `{description, value}` routes of an IP service
+// request into stored routes with IDs.
+func prepareIPService(s *Server, data map[string]any, _ *object) {
+	data["routes"] = convertRoutes(s, data["routes"], func(r map[string]any) map[string]any {
+		return map[string]any{"subnet": r["value"], "description": r["description"], "type": "IP_V4"}
+	})
+}
+
+// prepareApplication turns the `{value, allowEmbeddedIp, exactMatch}`
+// routes of an application request into stored domain routes with IDs.
+func prepareApplication(s *Server, data map[string]any, _ *object) {
+	data["routes"] = convertRoutes(s, data["routes"], func(r map[string]any) map[string]any {
+		return map[string]any{
+			"type":            "DOMAIN",
+			"domain":          r["value"],
+			"allowEmbeddedIp": r["allowEmbeddedIp"],
+			"exactMatch":      r["exactMatch"],
+		}
+	})
+}
+
+// convertRoutes converts the request routes in v with convert and gives
+// them IDs.
+func convertRoutes(s *Server, v any, convert func(map[string]any) map[string]any) []any {
+	routes := []any{}
+	list, _ := v.([]any)
+	for _, item := range list {
+		r, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		route := convert(r)
+		route["id"] = s.newID()
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// prepareUser defaults the status of new users and moves the devices of a
+// user create request into the devices collection.
+func prepareUser(s *Server, data map[string]any, old *object) {
+	if status, _ := data["status"].(string); status == "" {
+		data["status"] = "ACTIVE"
+		if old != nil {
+			data["status"] = old.data["status"]
+		}
+	}
+	if authType, _ := data["authType"].(string); authType == "" {
+		data["authType"] = "LOCAL"
+	}
+	if old == nil {
+		devices, _ := data["devices"].([]any)
+		for _, item := range devices {
+			if d, ok := item.(map[string]any); ok {
+				d["id"] = s.newID()
+				d["userId"] = data["id"]
+				prepareDevice(s, d, nil)
+				s.insert(s.collections["devices"], d)
+			}
+		}
+	}
+	delete(data, "devices")
+}
+
+// viewUser embeds the devices of a user.
+func viewUser(s *Server, o *object, data map[string]any) {
+	data["devices"] = s.children("devices", o.data["id"].(string))
+	data["connectionStatus"] = "OFFLINE"
+	data["licensed"] = data["status"] == "ACTIVE"
+}
+
+// setUserStatus returns an action that sets the status of a user.
+func setUserStatus(status string) action {
+	return func(_ *Server, o *object, _ url.Values) (int, any) {
+		o.data["status"] = status
+		return http.StatusNoContent, nil
+	}
+}
+
+// prepareDevice assigns tunnel addresses to new devices.
+func prepareDevice(s *Server, data map[string]any, old *object) {
+	if old == nil {
+		data["ipV4Address"] = fmt.Sprintf("100.97.%d.%d", s.nextID/256%256, s.nextID%256)
+		data["ipV6Address"] = fmt.Sprintf("fd00:a:b:d::%x", s.nextID)
+	}
+	data["connectionStatus"] = "OFFLINE"
+}
+
+// deviceProfile returns an OpenVPN profile for a device in the region of
+// the `regionId` query parameter.
+func deviceProfile(_ *Server, o *object, query url.Values) (int, any) {
+	region := query.Get("regionId")
+	if !knownRegion(region) {
+		return http.StatusBadRequest, map[string]any{"status": http.StatusBadRequest, "message": "unknown regionId"}
+	}
+	return http.StatusOK, fmt.Sprintf("client\ndev tun\nremote %s.fake.openvpn.com 1194 udp\n# device %s\n", region, o.data["id"])
+}
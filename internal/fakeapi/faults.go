@@ -0,0 +1,70 @@
+package fakeapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Fault describes a failure injected into the responses of a Server.
+type Fault struct {
+	// Method is the HTTP method of the affected requests; empty matches
+	// every method.
+	Method string
+	// Path is a prefix of the affected request paths below /api/v1, e.g.
+	// `hosts` or `networks/connectors`; empty matches every API path
+	// including the token endpoint.
+	Path string
+	// Status is the HTTP status returned instead of the real response, e.g.
+	// 429 or 503. Zero serves the real response, which is useful with
+	// Latency alone.
+	Status int
+	// RetryAfter is sent as the Retry-After header with Status.
+	RetryAfter time.Duration
+	// Latency delays the affected responses in addition to
+	// Options.Latency.
+	Latency time.Duration
+	// Times is the number of requests affected before the fault is
+	// removed; zero affects every matching request until ClearFaults.
+	Times int
+}
+
+// Inject adds f to the faults of s. When several faults match a request,
+// the one injected first applies.
+//
+// Parameters:
+//   - f: The fault to inject
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// takeFault returns the first fault matching r and consumes one of its
+// Times. s.mu must be held.
+func (s *Server) takeFault(r *http.Request) *Fault {
+	path := strings.TrimPrefix(r.URL.Path, apiPrefix)
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Path != "" && !strings.HasPrefix(path, strings.Trim(f.Path, "/")) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
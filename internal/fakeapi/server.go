@@ -0,0 +1,282 @@
+// Package fakeapi implements an in-memory fake of the CloudConnexa API for
+// tests that must run without network access or real credentials.
+//
+// The fake serves the OAuth token endpoint and the v1 endpoints used by the
+// provider's resources and data sources over TLS, keeps every object in
+// memory and generates IDs the way the API does. Faults such as rate
+// limiting, server errors, latency and eventual consistency can be injected
+// to exercise the provider's retry and polling logic.
+package fakeapi
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiPrefix is the path prefix of every v1 endpoint.
+const apiPrefix = "/api/v1/"
+
+// defaultTokenTTL is the lifetime of issued access tokens when
+// Options.TokenTTL is not set.
+const defaultTokenTTL = time.Hour
+
+// Options configures a Server.
+type Options struct {
+	// ClientID and ClientSecret are the credentials accepted by the token
+	// endpoint. When both are empty, any credentials are accepted.
+	ClientID     string
+	ClientSecret string
+	// TokenTTL is the lifetime reported in `expires_in` and after which
+	// tokens are rejected. Defaults to one hour.
+	TokenTTL time.Duration
+	// Latency delays every response.
+	Latency time.Duration
+	// ConsistencyLag is how long new objects stay invisible to reads and
+	// lists after they were created, like the eventually consistent API.
+	ConsistencyLag time.Duration
+	// ConnectorOnlineAfter is how long after creation a connector that is
+	// not suspended reports `online`. Zero means connectors stay `offline`,
+	// as they do until a connector is deployed for them.
+	ConnectorOnlineAfter time.Duration
+}
+
+// Server is an in-memory CloudConnexa API served over TLS. It is safe for
+// concurrent use.
+type Server struct {
+	// URL is the base URL of the server, e.g. `https://127.0.0.1:40123`.
+	URL string
+
+	opts Options
+	ts   *httptest.Server
+
+	mu          sync.Mutex
+	nextID      int
+	tokens      map[string]time.Time
+	collections map[string]*collection
+	settings    map[string]string
+	faults      []*Fault
+	requests    int
+}
+
+// New starts a Server seeded with an account owner, a default user group and
+// a set of VPN regions. The server must be stopped with Close.
+//
+// Parameters:
+//   - opts: The server options
+//
+// Returns:
+//   - *Server: The running server
+func New(opts Options) *Server {
+	if opts.TokenTTL == 0 {
+		opts.TokenTTL = defaultTokenTTL
+	}
+	s := &Server{
+		opts:     opts,
+		tokens:   map[string]time.Time{},
+		settings: defaultSettings(),
+	}
+	s.collections = newCollections()
+	s.seed()
+	s.ts = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.ts.URL
+	return s
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.ts.Close()
+}
+
+// Client returns an HTTP client that trusts the server's certificate.
+func (s *Server) Client() *http.Client {
+	return s.ts.Client()
+}
+
+// CertPool returns a certificate pool holding the server's certificate.
+func (s *Server) CertPool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(s.ts.Certificate())
+	return pool
+}
+
+// WriteCACert writes the server's certificate in PEM format to path, e.g.
+// for the provider's `ca_cert_file` argument.
+//
+// Parameters:
+//   - path: The file to write
+//
+// Returns:
+//   - error: An error if the file could not be written
+func (s *Server) WriteCACert(path string) error {
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.ts.Certificate().Raw})
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Requests returns the number of API requests served so far, including
+// token requests and requests answered by injected faults.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// serveHTTP applies latency and faults, then dispatches the request.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	fault := s.takeFault(r)
+	s.mu.Unlock()
+
+	latency := s.opts.Latency
+	if fault != nil {
+		latency += fault.Latency
+	}
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if fault != nil && fault.Status != 0 {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", fmt.Sprint(int(fault.RetryAfter.Seconds())))
+		}
+		writeError(w, fault.Status, http.StatusText(fault.Status))
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, apiPrefix) {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")
+	if path == "oauth/token" {
+		s.serveToken(w, r)
+		return
+	}
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "invalid or expired access token")
+		return
+	}
+	// Generous limits make the go-client rate limiters, which start at one
+	// request per second, speed up after the first response.
+	w.Header().Set("X-RateLimit-Replenish-Rate", "1000")
+	w.Header().Set("X-RateLimit-Replenish-Time", "1")
+	w.Header().Set("X-RateLimit-Remaining", "1000")
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.route(w, r.Method, strings.Split(path, "/"), r.URL.Query(), body)
+}
+
+// serveToken issues access tokens for the client-credentials grant, with
+// the credentials as HTTP basic authentication.
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	id, secret, ok := r.BasicAuth()
+	if !ok || id == "" || secret == "" {
+		writeError(w, http.StatusUnauthorized, "client credentials are required")
+		return
+	}
+	if (s.opts.ClientID != "" || s.opts.ClientSecret != "") && (id != s.opts.ClientID || secret != s.opts.ClientSecret) {
+		writeError(w, http.StatusUnauthorized, "invalid client credentials")
+		return
+	}
+
+	s.mu.Lock()
+	token := fmt.Sprintf("fake-token-%d", len(s.tokens)+1)
+	s.tokens[token] = time.Now().Add(s.opts.TokenTTL)
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   int(s.opts.TokenTTL.Seconds()),
+	})
+}
+
+// authorized reports whether r carries an unexpired token issued by s.
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiry, ok := s.tokens[token]
+	return ok && time.Now().Before(expiry)
+}
+
+// route dispatches an authorized API request. path holds the segments
+// below /api/v1. s.mu must be held.
+func (s *Server) route(w http.ResponseWriter, method string, path []string, query url.Values, body []byte) {
+	switch {
+	case path[0] == "settings" || path[0] == "dns-log" || path[0] == "access-visibility":
+		s.serveSetting(w, method, strings.Join(path, "/"), body)
+		return
+	case path[0] == "regions" && len(path) == 1 && method == http.MethodGet:
+		writeJSON(w, http.StatusOK, regions)
+		return
+	case path[0] == "sessions" && len(path) == 1 && method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]any{"sessions": []any{}})
+		return
+	}
+	for _, n := range []int{2, 1} {
+		if len(path) < n {
+			continue
+		}
+		if c, ok := s.collections[strings.Join(path[:n], "/")]; ok {
+			s.serveCollection(w, c, method, path[n:], query, body)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "no such endpoint")
+}
+
+// newID returns a new object ID in the UUID format of the API. s.mu must be
+// held.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", s.nextID)
+}
+
+// writeJSON writes v as a JSON response.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeText writes a plain text response.
+func writeText(w http.ResponseWriter, status int, text string) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, text)
+}
+
+// writeError writes an error response in the format of the API.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"status":    status,
+		"errorCode": strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_")),
+		"message":   message,
+	})
+}
@@ -0,0 +1,354 @@
+package fakeapi
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer starts a Server for the duration of the test.
+func testServer(t *testing.T, opts Options) *Server {
+	t.Helper()
+	s := New(opts)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// testToken requests an access token with the given credentials.
+func testToken(t *testing.T, s *Server, id, secret string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, s.URL+"/api/v1/oauth/token", strings.NewReader(`{"grant_type":"client_credentials"}`))
+	require.NoError(t, err)
+	req.SetBasicAuth(id, secret)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := s.Client().Do(req)
+	require.NoError(t, err)
+	defer func() { _ = res.Body.Close() }()
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	_ = json.NewDecoder(res.Body).Decode(&body)
+	return res.StatusCode, body.AccessToken
+}
+
+// testClient sends authenticated API requests to a Server.
+type testClient struct {
+	t     *testing.T
+	s     *Server
+	token string
+}
+
+// newTestClient returns a testClient holding a fresh token.
+func newTestClient(t *testing.T, s *Server) *testClient {
+	t.Helper()
+	status, token := testToken(t, s, "id", "secret")
+	require.Equal(t, http.StatusOK, status)
+	return &testClient{t: t, s: s, token: token}
+}
+
+// do sends a request to path below /api/v1 and returns the status, the
+// headers and the raw body.
+func (c *testClient) do(method, path string, body any) (int, http.Header, []byte) {
+	c.t.Helper()
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(c.t, err)
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, c.s.URL+"/api/v1/"+path, reader)
+	require.NoError(c.t, err)
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	res, err := c.s.Client().Do(req)
+	require.NoError(c.t, err)
+	defer func() { _ = res.Body.Close() }()
+	data, err := io.ReadAll(res.Body)
+	require.NoError(c.t, err)
+	return res.StatusCode, res.Header, data
+}
+
+// json sends a request and decodes the JSON object response.
+func (c *testClient) json(method, path string, body any) (int, map[string]any) {
+	c.t.Helper()
+	status, _, data := c.do(method, path, body)
+	var v map[string]any
+	if len(data) > 0 {
+		require.NoError(c.t, json.Unmarshal(data, &v), string(data))
+	}
+	return status, v
+}
+
+func TestUnitToken(t *testing.T) {
+	s := testServer(t, Options{ClientID: "id", ClientSecret: "secret", TokenTTL: 50 * time.Millisecond})
+
+	status, _ := testToken(t, s, "id", "wrong")
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	c := newTestClient(t, s)
+	status, _ = c.json(http.MethodGet, "hosts", nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	time.Sleep(60 * time.Millisecond)
+	status, _ = c.json(http.MethodGet, "hosts", nil)
+	assert.Equal(t, http.StatusUnauthorized, status, "expired token")
+
+	c.token = "forged"
+	status, _ = c.json(http.MethodGet, "hosts", nil)
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestUnitCollections(t *testing.T) {
+	s := testServer(t, Options{})
+	c := newTestClient(t, s)
+
+	status, host := c.json(http.MethodPost, "hosts", map[string]any{"name": "web", "description": "d"})
+	require.Equal(t, http.StatusCreated, status)
+	hostID := host["id"].(string)
+	assert.NotEmpty(t, hostID)
+	assert.Len(t, host["systemSubnets"], 2)
+	assert.Equal(t, "SPLIT_TUNNEL_ON", host["internetAccess"])
+
+	status, _ = c.json(http.MethodPost, "hosts/connectors", map[string]any{"name": "c", "vpnRegionId": "us-east-1"})
+	assert.Equal(t, http.StatusBadRequest, status, "missing hostId")
+	status, _ = c.json(http.MethodPost, "hosts/connectors?hostId=missing", map[string]any{"name": "c", "vpnRegionId": "us-east-1"})
+	assert.Equal(t, http.StatusNotFound, status, "unknown host")
+	status, _ = c.json(http.MethodPost, "hosts/connectors?hostId="+hostID, map[string]any{"name": "c", "vpnRegionId": "mars-1"})
+	assert.Equal(t, http.StatusBadRequest, status, "unknown region")
+	status, connector := c.json(http.MethodPost, "hosts/connectors?hostId="+hostID, map[string]any{"name": "c", "vpnRegionId": "us-east-1"})
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, hostID, connector["networkItemId"])
+	assert.Equal(t, "HOST", connector["networkItemType"])
+	assert.Equal(t, "offline", connector["connectionStatus"])
+	assert.NotEmpty(t, connector["ipV4Address"])
+
+	status, host = c.json(http.MethodPut, "hosts/"+hostID, map[string]any{"name": "web2", "description": "d2"})
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "web2", host["name"])
+	assert.Len(t, host["systemSubnets"], 2, "server fields survive updates")
+	assert.Len(t, host["connectors"], 1)
+
+	for i := range 3 {
+		status, _ := c.json(http.MethodPost, "dns-records", map[string]any{"domain": string(rune('a'+i)) + ".example.com"})
+		require.Equal(t, http.StatusCreated, status)
+	}
+	status, page := c.json(http.MethodGet, "dns-records?page=1&size=2", nil)
+	require.Equal(t, http.StatusOK, status)
+	assert.Len(t, page["content"], 1)
+	assert.EqualValues(t, 3, page["totalElements"])
+	assert.EqualValues(t, 2, page["totalPages"])
+
+	status, _ = c.json(http.MethodDelete, "hosts/"+hostID, nil)
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _ = c.json(http.MethodGet, "hosts/"+hostID, nil)
+	assert.Equal(t, http.StatusNotFound, status)
+	status, _ = c.json(http.MethodGet, "hosts/connectors/"+connector["id"].(string), nil)
+	assert.Equal(t, http.StatusNotFound, status, "connectors are deleted with their host")
+}
+
+func TestUnitRoutesAndServices(t *testing.T) {
+	s := testServer(t, Options{})
+	c := newTestClient(t, s)
+
+	_, network := c.json(http.MethodPost, "networks", map[string]any{"name": "n", "tunnelingProtocol": ""})
+	networkID := network["id"].(string)
+	assert.Equal(t, "OPENVPN", network["tunnelingProtocol"])
+
+	status, route := c.json(http.MethodPost, "networks/routes?networkId="+networkID, map[string]any{"description": "r", "value": "fd00::/64"})
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "fd00::/64", route["subnet"])
+	assert.Equal(t, "IP_V6", route["type"])
+	assert.Nil(t, route["value"])
+
+	_, network = c.json(http.MethodGet, "networks/"+networkID, nil)
+	require.Len(t, network["routes"], 1)
+	assert.Equal(t, route["id"], network["routes"].([]any)[0].(map[string]any)["id"])
+
+	_, service := c.json(http.MethodPost, "networks/ip-services?networkId="+networkID, map[string]any{
+		"name":   "s",
+		"routes": []any{map[string]any{"description": "d", "value": "10.0.0.0/24"}},
+	})
+	routes := service["routes"].([]any)
+	require.Len(t, routes, 1)
+	assert.Equal(t, "10.0.0.0/24", routes[0].(map[string]any)["subnet"])
+	assert.NotEmpty(t, routes[0].(map[string]any)["id"])
+
+	_, app := c.json(http.MethodPost, "networks/applications?networkId="+networkID, map[string]any{
+		"name":   "a",
+		"routes": []any{map[string]any{"value": "example.com", "allowEmbeddedIp": true}},
+	})
+	routes = app["routes"].([]any)
+	require.Len(t, routes, 1)
+	assert.Equal(t, "DOMAIN", routes[0].(map[string]any)["type"])
+	assert.Equal(t, "example.com", routes[0].(map[string]any)["domain"])
+}
+
+func TestUnitConnectorActions(t *testing.T) {
+	s := testServer(t, Options{ConnectorOnlineAfter: 30 * time.Millisecond})
+	c := newTestClient(t, s)
+
+	_, network := c.json(http.MethodPost, "networks", map[string]any{"name": "n"})
+	networkID := network["id"].(string)
+	_, connector := c.json(http.MethodPost, "networks/connectors?networkId="+networkID, map[string]any{
+		"name":        "c",
+		"vpnRegionId": "eu-west-1",
+		"ipSecConfig": map[string]any{"platform": "AWS"},
+	})
+	id := connector["id"].(string)
+	assert.Equal(t, "IPSEC", connector["tunnelingProtocol"])
+	assert.Equal(t, IPsecStopped, connector["ipSecConfig"].(map[string]any)["connectorState"])
+
+	status, _, profile := c.do(http.MethodPost, "networks/connectors/"+id+"/profile", nil)
+	require.Equal(t, http.StatusOK, status)
+	assert.Contains(t, string(profile), "remote eu-west-1.fake.openvpn.com 1194 udp")
+	status, _, token := c.do(http.MethodPost, "networks/connectors/"+id+"/profile/encrypt", nil)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "fake-connector-token-"+id, string(token))
+
+	status, _ = c.json(http.MethodPost, "networks/connectors/"+id+"/ipsec/start", nil)
+	require.Equal(t, http.StatusNoContent, status)
+	_, connector = c.json(http.MethodGet, "networks/connectors/"+id, nil)
+	assert.Equal(t, IPsecStarted, connector["ipSecConfig"].(map[string]any)["connectorState"])
+
+	time.Sleep(40 * time.Millisecond)
+	_, connector = c.json(http.MethodGet, "networks/connectors/"+id, nil)
+	assert.Equal(t, "online", connector["connectionStatus"])
+
+	status, _ = c.json(http.MethodPut, "networks/connectors/"+id+"/suspend", nil)
+	require.Equal(t, http.StatusNoContent, status)
+	_, connector = c.json(http.MethodGet, "networks/connectors/"+id, nil)
+	assert.Equal(t, "offline", connector["connectionStatus"])
+	assert.Equal(t, false, connector["licensed"])
+
+	status, _ = c.json(http.MethodPut, "networks/connectors/"+id+"/restart", nil)
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestUnitUsersAndDevices(t *testing.T) {
+	s := testServer(t, Options{})
+	c := newTestClient(t, s)
+
+	_, page := c.json(http.MethodGet, "users", nil)
+	require.Len(t, page["content"], 1, "the account owner is seeded")
+	_, page = c.json(http.MethodGet, "user-groups", nil)
+	require.Len(t, page["content"], 1, "the default group is seeded")
+
+	_, user := c.json(http.MethodPost, "users", map[string]any{
+		"username": "alice",
+		"devices":  []any{map[string]any{"name": "laptop"}},
+	})
+	userID := user["id"].(string)
+	assert.Equal(t, "ACTIVE", user["status"])
+	require.Len(t, user["devices"], 1)
+	deviceID := user["devices"].([]any)[0].(map[string]any)["id"].(string)
+
+	_, page = c.json(http.MethodGet, "devices?userId="+userID, nil)
+	assert.Len(t, page["content"], 1)
+	status, _ := c.json(http.MethodGet, "devices/"+deviceID+"?userId=other", nil)
+	assert.Equal(t, http.StatusNotFound, status, "devices are scoped to their user")
+
+	status, _ = c.json(http.MethodPut, "users/"+userID+"/suspend", nil)
+	require.Equal(t, http.StatusNoContent, status)
+	_, user = c.json(http.MethodGet, "users/"+userID, nil)
+	assert.Equal(t, "SUSPENDED", user["status"])
+	assert.Len(t, user["devices"], 1, "updates keep devices")
+}
+
+func TestUnitSettings(t *testing.T) {
+	s := testServer(t, Options{})
+	c := newTestClient(t, s)
+
+	status, _, body := c.do(http.MethodGet, "settings/wpc/topology", nil)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "FULL_MESH", string(body))
+
+	status, _, body = c.do(http.MethodPut, "settings/users/connection-timeout", 60)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "60", string(body))
+	assert.Equal(t, "60", s.Setting("settings/users/connection-timeout"))
+
+	status, _, _ = c.do(http.MethodPut, "access-visibility/enable", true)
+	require.Equal(t, http.StatusOK, status)
+	_, _, body = c.do(http.MethodGet, "access-visibility/enabled", nil)
+	assert.Equal(t, "true", string(body))
+
+	status, _, _ = c.do(http.MethodGet, "settings/unknown", nil)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	status, _, body = c.do(http.MethodGet, "regions", nil)
+	require.Equal(t, http.StatusOK, status)
+	assert.Contains(t, string(body), `"us-east-1"`)
+}
+
+func TestUnitFaults(t *testing.T) {
+	s := testServer(t, Options{})
+	c := newTestClient(t, s)
+
+	s.Inject(Fault{Method: http.MethodPost, Path: "hosts", Status: http.StatusTooManyRequests, RetryAfter: 2 * time.Second, Times: 2})
+	for range 2 {
+		status, header, _ := c.do(http.MethodPost, "hosts", map[string]any{"name": "h"})
+		assert.Equal(t, http.StatusTooManyRequests, status)
+		assert.Equal(t, "2", header.Get("Retry-After"))
+	}
+	status, _ := c.json(http.MethodGet, "hosts", nil)
+	assert.Equal(t, http.StatusOK, status, "other methods are not affected")
+	status, _ = c.json(http.MethodPost, "hosts", map[string]any{"name": "h"})
+	assert.Equal(t, http.StatusCreated, status, "the fault is consumed")
+
+	s.Inject(Fault{Path: "networks", Status: http.StatusServiceUnavailable})
+	for range 3 {
+		status, _ = c.json(http.MethodGet, "networks", nil)
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+	}
+	s.ClearFaults()
+	status, _ = c.json(http.MethodGet, "networks", nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	s.Inject(Fault{Path: "regions", Latency: 50 * time.Millisecond, Times: 1})
+	start := time.Now()
+	status, _, _ = c.do(http.MethodGet, "regions", nil)
+	assert.Equal(t, http.StatusOK, status)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestUnitConsistencyLag(t *testing.T) {
+	s := testServer(t, Options{ConsistencyLag: 50 * time.Millisecond})
+	c := newTestClient(t, s)
+
+	_, page := c.json(http.MethodGet, "users", nil)
+	assert.Len(t, page["content"], 1, "seeded objects are visible at once")
+
+	status, group := c.json(http.MethodPost, "user-groups", map[string]any{"name": "g"})
+	require.Equal(t, http.StatusCreated, status)
+	id := group["id"].(string)
+	status, _ = c.json(http.MethodGet, "user-groups/"+id, nil)
+	assert.Equal(t, http.StatusNotFound, status)
+	_, page = c.json(http.MethodGet, "user-groups", nil)
+	assert.Len(t, page["content"], 1)
+
+	time.Sleep(60 * time.Millisecond)
+	status, _ = c.json(http.MethodGet, "user-groups/"+id, nil)
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestUnitWriteCACert(t *testing.T) {
+	s := testServer(t, Options{})
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, s.WriteCACert(path))
+
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = &tls.Config{RootCAs: s.CertPool()}
+	res, err := (&http.Client{Transport: tr}).Get(s.URL + "/api/v1/regions")
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
@@ -0,0 +1,128 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// regions are the VPN regions of the fake account.
+var regions = []map[string]any{
+	{"id": "eu-central-1", "continent": "Europe", "country": "Germany", "countryIso": "DE", "regionName": "Frankfurt"},
+	{"id": "eu-west-1", "continent": "Europe", "country": "Ireland", "countryIso": "IE", "regionName": "Dublin"},
+	{"id": "us-east-1", "continent": "North America", "country": "United States", "countryIso": "US", "regionName": "Virginia"},
+	{"id": "us-west-1", "continent": "North America", "country": "United States", "countryIso": "US", "regionName": "California"},
+}
+
+// knownRegion reports whether id is the ID of one of regions.
+func knownRegion(id string) bool {
+	return slices.ContainsFunc(regions, func(r map[string]any) bool { return r["id"] == id })
+}
+
+// defaultSettings returns the settings of a new account by path below
+// /api/v1, as the raw response bodies of their GET endpoints.
+func defaultSettings() map[string]string {
+	return map[string]string{
+		"settings/auth/trusted-devices-allowed":              "true",
+		"settings/auth/two-factor-auth":                      "false",
+		"settings/dns/custom-servers":                        "",
+		"settings/dns/default-suffix":                        "",
+		"settings/dns/proxy-enabled":                         "false",
+		"settings/dns/zones":                                 `{"zones":[]}`,
+		"settings/user/connect-auth":                         "NO_AUTH",
+		"settings/user/device-allowance":                     "3",
+		"settings/user/device-allowance-force-update":        "false",
+		"settings/user/device-enforcement":                   "OFF",
+		"settings/user/profile-distribution":                 "AUTOMATIC",
+		"settings/users/connection-timeout":                  "20",
+		"settings/wpc/client-options":                        "[]",
+		"settings/wpc/default-region":                        "us-east-1",
+		"settings/wpc/domain-routing-subnet":                 `{"ipV4Address":"100.64.0.0/16","ipV6Address":"fd00:e::/64"}`,
+		"settings/wpc/snat":                                  "true",
+		"settings/wpc/subnet":                                `{"ipV4Address":["100.96.0.0/11"],"ipV6Address":["fd00:a:b::/48"]}`,
+		"settings/wpc/topology":                              "FULL_MESH",
+		"settings/wpc/routes-advanced-configuration-enabled": "false",
+		"settings/wpc/ip-allocation-mode":                    "BOTH",
+		"dns-log/user-dns-resolutions/enabled":               "false",
+		"access-visibility/enabled":                          "false",
+	}
+}
+
+// toggles maps the PUT endpoints that switch a boolean setting on or off to
+// the setting and its new value.
+var toggles = map[string][2]string{
+	"dns-log/user-dns-resolutions/enable":  {"dns-log/user-dns-resolutions/enabled", "true"},
+	"dns-log/user-dns-resolutions/disable": {"dns-log/user-dns-resolutions/enabled", "false"},
+	"access-visibility/enable":             {"access-visibility/enabled", "true"},
+	"access-visibility/disable":            {"access-visibility/enabled", "false"},
+}
+
+// serveSetting reads or replaces the setting at path. PUT stores the
+// request body as is and echoes it, like the API. s.mu must be held.
+func (s *Server) serveSetting(w http.ResponseWriter, method, path string, body []byte) {
+	if toggle, ok := toggles[path]; ok && method == http.MethodPut {
+		s.settings[toggle[0]] = toggle[1]
+		writeJSON(w, http.StatusOK, toggle[1] == "true")
+		return
+	}
+	value, ok := s.settings[path]
+	if !ok {
+		writeError(w, http.StatusNotFound, "no such setting")
+		return
+	}
+	switch method {
+	case http.MethodGet:
+	case http.MethodPut:
+		value = strings.TrimSpace(string(body))
+		s.settings[path] = value
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(value))
+}
+
+// Setting returns the raw value of the setting at path below /api/v1, e.g.
+// `settings/wpc/topology`.
+func (s *Server) Setting(path string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.settings[strings.Trim(path, "/")]
+}
+
+// seed creates the objects every account has: the owner and the default
+// user group.
+func (s *Server) seed() {
+	group := map[string]any{
+		"id":                 s.newID(),
+		"name":               "Default",
+		"connectAuth":        "AUTO",
+		"internetAccess":     "SPLIT_TUNNEL_ON",
+		"maxDevice":          3,
+		"systemSubnets":      []any{},
+		"vpnRegionIds":       []any{},
+		"allRegionsIncluded": true,
+	}
+	s.insert(s.collections["user-groups"], group)
+	s.insert(s.collections["users"], map[string]any{
+		"id":        s.newID(),
+		"username":  "owner",
+		"email":     "owner@example.com",
+		"firstName": "Account",
+		"lastName":  "Owner",
+		"role":      "OWNER",
+		"authType":  "LOCAL",
+		"groupId":   group["id"],
+		"status":    "ACTIVE",
+	})
+	// Seeded objects predate the server and are not subject to
+	// Options.ConsistencyLag.
+	for _, c := range s.collections {
+		for _, o := range c.objects {
+			o.created = time.Time{}
+		}
+	}
+}
@@ -1,17 +1,140 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
 	"github.com/OpenVPN/terraform-provider-cloudconnexa/cloudconnexa"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 // main is the entry point for the Terraform provider plugin.
-// It initializes and serves the CloudConnexa provider using the Terraform plugin SDK.
+// It serves the CloudConnexa provider over the Terraform plugin protocol version 6,
+// muxing the Terraform plugin SDK provider with the part of the provider
+// implemented with the Terraform plugin framework at protocol version 5 and
+// upgrading the result.
+//
+// Run with one of the subcommands, it works on an existing account instead.
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return cloudconnexa.Provider()
-		},
+	if len(os.Args) > 1 {
+		if run, ok := subcommands[os.Args[1]]; ok {
+			code, err := run(os.Args[2:])
+			if errors.Is(err, flag.ErrHelp) {
+				os.Exit(0)
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", os.Args[1], err)
+			}
+			os.Exit(code)
+		}
+	}
+
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	server, err := cloudconnexa.ProviderServerFactory()()
+	if err != nil {
+		log.Fatal(err)
+	}
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+	err = tf6server.Serve("registry.terraform.io/OpenVPN/cloudconnexa", func() tfprotov6.ProviderServer {
+		return server
+	}, serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// subcommands are the commands of the provider binary besides serving the
+// provider. They return the exit code and an error to print, if any.
+var subcommands = map[string]func(args []string) (int, error){
+	"export":         runExport,
+	"find-unmanaged": runFindUnmanaged,
+}
+
+// accountFlags adds the flags selecting the account to flags. The returned
+// function returns them as provider arguments. Credentials are read from
+// the provider's environment variables and credentials file; without
+// -cloud-id or -base-url, the account is selected by the credentials profile.
+func accountFlags(flags *flag.FlagSet) func() map[string]interface{} {
+	cloudID := flags.String("cloud-id", "", "cloud ID of the account")
+	baseURL := flags.String("base-url", "", "base API URL of the account")
+	return func() map[string]interface{} {
+		config := map[string]interface{}{}
+		if *cloudID != "" {
+			config["cloud_id"] = *cloudID
+		}
+		if *baseURL != "" {
+			config["base_url"] = *baseURL
+		}
+		return config
+	}
+}
+
+// parseFlags parses args and rejects positional arguments.
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", flags.Args())
+	}
+	return nil
+}
+
+// runExport implements `terraform-provider-cloudconnexa export`, which
+// writes Terraform configuration and import blocks for every object of an
+// account.
+func runExport(args []string) (int, error) {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	dir := flags.String("dir", ".", "directory to write the configuration files to")
+	account := accountFlags(flags)
+	if err := parseFlags(flags, args); err != nil {
+		return 1, err
+	}
+	if err := cloudconnexa.Export(context.Background(), *dir, account(), os.Stderr); err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+// runFindUnmanaged implements `terraform-provider-cloudconnexa
+// find-unmanaged`, which lists the objects of an account that none of the
+// given state files manages. It exits with 2 if there are any, so that CI
+// can flag objects created outside Terraform.
+func runFindUnmanaged(args []string) (int, error) {
+	flags := flag.NewFlagSet("find-unmanaged", flag.ContinueOnError)
+	var states []string
+	flags.Func("state", "Terraform state file; may be repeated", func(path string) error {
+		states = append(states, path)
+		return nil
 	})
+	format := flags.String("format", "table", "output format: table or json")
+	account := accountFlags(flags)
+	if err := parseFlags(flags, args); err != nil {
+		return 1, err
+	}
+	if len(states) == 0 {
+		return 1, errors.New("at least one -state is required")
+	}
+	objects, err := cloudconnexa.FindUnmanaged(context.Background(), account(), states, os.Stderr)
+	if err != nil {
+		return 1, err
+	}
+	if err := cloudconnexa.WriteUnmanaged(os.Stdout, objects, *format); err != nil {
+		return 1, err
+	}
+	if len(objects) > 0 {
+		return 2, nil
+	}
+	return 0, nil
 }
@@ -0,0 +1,28 @@
+#!/bin/sh
+# Rebuilds third_party/cloudconnexa-go-client from an upstream release of
+# github.com/openvpn/cloudconnexa-go-client and cloudconnexa-go-client.patch.
+#
+# Usage: third_party/update-cloudconnexa-go-client.sh [tag]
+set -eu
+
+tag=${1:-v2.5.1}
+here=$(cd "$(dirname "$0")" && pwd)
+fork=$here/cloudconnexa-go-client
+
+src=$(go mod download -json "github.com/openvpn/cloudconnexa-go-client/v2@$tag" |
+	sed -n 's/^[[:space:]]*"Dir": "\(.*\)",$/\1/p')
+if [ -z "$src" ]; then
+	echo "cannot download cloudconnexa-go-client $tag" >&2
+	exit 1
+fi
+
+readme=$(mktemp)
+cp "$fork/README.md" "$readme"
+rm -rf "$fork"
+mkdir -p "$fork"
+cp -R "$src/go.mod" "$src/go.sum" "$src/LICENSE" "$src/cloudconnexa" "$fork/"
+cp "$readme" "$fork/README.md"
+rm -f "$readme"
+chmod -R u+w "$fork"
+
+patch -d "$fork" -p1 --no-backup-if-mismatch < "$here/cloudconnexa-go-client.patch"